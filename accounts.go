@@ -0,0 +1,178 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"maps"
+)
+
+// AvailableToSpend returns the balance plus the overdraft limit. bunq already
+// deducts reservations (e.g. pending card payments) from the balance.
+func (a *MonetaryAccountBank) AvailableToSpend() (*Amount, error) {
+	return availableToSpend(a.Balance, a.OverdraftLimit)
+}
+
+// AvailableToSpend returns the balance plus the overdraft limit. bunq already
+// deducts reservations (e.g. pending card payments) from the balance.
+func (a *MonetaryAccountJoint) AvailableToSpend() (*Amount, error) {
+	return availableToSpend(a.Balance, a.OverdraftLimit)
+}
+
+func availableToSpend(balance, overdraftLimit *Amount) (*Amount, error) {
+	if balance == nil {
+		return nil, fmt.Errorf("account has no balance")
+	}
+	if overdraftLimit == nil || overdraftLimit.Value == "" {
+		return &Amount{Value: balance.Value, Currency: balance.Currency}, nil
+	}
+	return balance.Add(overdraftLimit)
+}
+
+// MonetaryAccountStatus is the lifecycle status of a monetary account.
+type MonetaryAccountStatus string
+
+const (
+	MonetaryAccountActive        MonetaryAccountStatus = "ACTIVE"
+	MonetaryAccountBlocked       MonetaryAccountStatus = "BLOCKED"
+	MonetaryAccountCancelled     MonetaryAccountStatus = "CANCELLED"
+	MonetaryAccountPendingReopen MonetaryAccountStatus = "PENDING_REOPEN"
+)
+
+// ListByStatus iterates over the user's bank accounts with the given status.
+// The status is sent as a query filter and also checked on each item, so the
+// result is correct even where bunq ignores the filter.
+func (s *MonetaryAccountBankService) ListByStatus(ctx context.Context, status MonetaryAccountStatus, opts *ListOptions) iter.Seq2[MonetaryAccountBank, error] {
+	var o ListOptions
+	if opts != nil {
+		o = *opts
+	}
+	o.Filters = maps.Clone(o.Filters)
+	if o.Filters == nil {
+		o.Filters = map[string]string{}
+	}
+	o.Filters["status"] = string(status)
+
+	return func(yield func(MonetaryAccountBank, error) bool) {
+		for a, err := range s.List(ctx, &o) {
+			if err != nil {
+				yield(a, err)
+				return
+			}
+			if a.Status != string(status) {
+				continue
+			}
+			if !yield(a, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Statuses of a joint account's co-owner, as in CoOwner.Status.
+const (
+	CoOwnerAccepted = "ACCEPTED"
+	CoOwnerPending  = "PENDING"
+	CoOwnerRejected = "REJECTED"
+	CoOwnerRevoked  = "REVOKED"
+)
+
+// PendingCoOwners returns the co-owners of a joint account who have not yet
+// accepted the invitation to it.
+func (a *MonetaryAccountJoint) PendingCoOwners() []*CoOwner {
+	var pending []*CoOwner
+	for _, co := range a.AllCoOwner {
+		if co != nil && co.Status == CoOwnerPending {
+			pending = append(pending, co)
+		}
+	}
+	return pending
+}
+
+// PendingCoOwner is a co-owner of a joint account whose approval is pending.
+type PendingCoOwner struct {
+	MonetaryAccountID int
+	CoOwner           *CoOwner
+}
+
+// ListPendingApprovals iterates over the co-owners of the user's joint
+// accounts who have not yet accepted their invitation.
+func (s *MonetaryAccountJointService) ListPendingApprovals(ctx context.Context, opts *ListOptions) iter.Seq2[PendingCoOwner, error] {
+	return func(yield func(PendingCoOwner, error) bool) {
+		for a, err := range s.List(ctx, opts) {
+			if err != nil {
+				yield(PendingCoOwner{}, err)
+				return
+			}
+			for _, co := range a.PendingCoOwners() {
+				if !yield(PendingCoOwner{MonetaryAccountID: a.ID, CoOwner: co}, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TotalBalanceOptions controls TotalBalance.
+type TotalBalanceOptions struct {
+	// SkipOtherCurrencies leaves out accounts in another currency instead
+	// of failing.
+	SkipOtherCurrencies bool
+}
+
+// TotalBalance sums the balances of all active monetary accounts, of every
+// type, in the given currency, which is normalized as by Amount.Normalize. By
+// default an active account in another currency is an error, as bunq does not
+// convert; opts may be nil.
+func (c *Client) TotalBalance(ctx context.Context, currency string, opts *TotalBalanceOptions) (*Amount, error) {
+	total := &Amount{Value: "0.00", Currency: currency}
+	if err := total.Normalize(); err != nil {
+		return nil, err
+	}
+	currency = total.Currency
+	for a, err := range c.MonetaryAccount.List(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		id, status, balance, ok := accountSummary(a.Object())
+		if !ok || status != string(MonetaryAccountActive) || balance == nil {
+			continue
+		}
+		if balance.Currency != currency {
+			if opts != nil && opts.SkipOtherCurrencies {
+				continue
+			}
+			return nil, fmt.Errorf("account %d is in %s, not %s", id, balance.Currency, currency)
+		}
+		if total, err = total.Add(balance); err != nil {
+			return nil, fmt.Errorf("account %d: %w", id, err)
+		}
+	}
+	return total, nil
+}
+
+// accountSummary returns the ID, status and balance of a concrete monetary
+// account such as *MonetaryAccountBank, as returned by MonetaryAccount.Object.
+func accountSummary(account any) (id int, status string, balance *Amount, ok bool) {
+	switch a := account.(type) {
+	case *MonetaryAccountLight:
+		return a.ID, a.Status, a.Balance, true
+	case *MonetaryAccountBank:
+		return a.ID, a.Status, a.Balance, true
+	case *MonetaryAccountExternal:
+		return a.ID, a.Status, a.Balance, true
+	case *MonetaryAccountInvestment:
+		return a.ID, a.Status, a.Balance, true
+	case *MonetaryAccountJoint:
+		return a.ID, a.Status, a.Balance, true
+	case *MonetaryAccountSavings:
+		return a.ID, a.Status, a.Balance, true
+	case *MonetaryAccountSwitchService:
+		return a.ID, a.Status, a.Balance, true
+	case *MonetaryAccountExternalSavings:
+		return a.ID, a.Status, a.Balance, true
+	case *MonetaryAccountCard:
+		return a.ID, a.Status, a.Balance, true
+	}
+	return 0, "", nil, false
+}
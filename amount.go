@@ -0,0 +1,202 @@
+package bunq
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// UnmarshalJSON decodes an Amount whose value is a JSON string, as bunq
+// usually sends it, or a JSON number, as some nested objects have it. A
+// number keeps its literal digits, e.g. 12.5 becomes "12.5". Since the
+// generated types hold amounts as *Amount, this applies at any depth,
+// including in slices and maps.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Value    json.RawMessage `json:"value"`
+		Currency string          `json:"currency"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	a.Currency = raw.Currency
+	a.Value = ""
+	if len(raw.Value) == 0 || string(raw.Value) == "null" {
+		return nil
+	}
+	if raw.Value[0] == '"' {
+		return json.Unmarshal(raw.Value, &a.Value)
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw.Value, &n); err != nil {
+		return fmt.Errorf("amount value: cannot unmarshal %s", raw.Value)
+	}
+	a.Value = n.String()
+	return nil
+}
+
+// NewAmountFromString creates an Amount from a decimal value such as "12.5"
+// and a currency code, which is normalized as by Normalize. The value is
+// written with two decimals, e.g. "12.50".
+func NewAmountFromString(value, currency string) (*Amount, error) {
+	a := &Amount{Value: value, Currency: currency}
+	if err := a.Normalize(); err != nil {
+		return nil, err
+	}
+	units, err := a.MinorUnits()
+	if err != nil {
+		return nil, err
+	}
+	return amountFromMinorUnits(units, a.Currency), nil
+}
+
+// Normalize uppercases the currency code, as bunq rejects lowercase ones,
+// and checks that it is three letters: "eur" becomes "EUR", "EURO" is an
+// error.
+func (a *Amount) Normalize() error {
+	c := strings.ToUpper(a.Currency)
+	if len(c) != 3 || strings.Trim(c, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+		return fmt.Errorf("invalid currency %q: want a three-letter ISO 4217 code", a.Currency)
+	}
+	a.Currency = c
+	return nil
+}
+
+// MinorUnits returns the Amount's value in minor units, e.g. 1234 for "12.34".
+// Values with more than two decimals are rejected.
+func (a *Amount) MinorUnits() (int64, error) {
+	s := a.Value
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" || len(frac) > 2 {
+		return 0, fmt.Errorf("invalid amount value %q", a.Value)
+	}
+	for len(frac) < 2 {
+		frac += "0"
+	}
+	n, err := strconv.ParseUint(whole+frac, 10, 63)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount value %q", a.Value)
+	}
+	if neg {
+		return -int64(n), nil
+	}
+	return int64(n), nil
+}
+
+// Decimal returns the Amount's value as an exact rational number, for callers
+// that need arbitrary precision instead of float64.
+func (a *Amount) Decimal() (*big.Rat, error) {
+	// big.Rat also accepts fractions and exponents; bunq only sends plain
+	// decimals, so anything else is malformed.
+	s := strings.TrimPrefix(a.Value, "-")
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" || strings.Trim(whole+frac, "0123456789") != "" {
+		return nil, fmt.Errorf("invalid amount value %q", a.Value)
+	}
+	r, ok := new(big.Rat).SetString(a.Value)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount value %q", a.Value)
+	}
+	return r, nil
+}
+
+// amountFromMinorUnits builds an Amount from a value in minor units.
+func amountFromMinorUnits(units int64, currency string) *Amount {
+	sign := ""
+	if units < 0 {
+		sign = "-"
+		units = -units
+	}
+	return &Amount{
+		Value:    fmt.Sprintf("%s%d.%02d", sign, units/100, units%100),
+		Currency: currency,
+	}
+}
+
+// Add returns a + b. Both amounts must be in the same currency.
+func (a *Amount) Add(b *Amount) (*Amount, error) {
+	x, y, err := minorUnitsPair(a, b)
+	if err != nil {
+		return nil, err
+	}
+	return amountFromMinorUnits(x+y, a.Currency), nil
+}
+
+// Sub returns a - b. Both amounts must be in the same currency.
+func (a *Amount) Sub(b *Amount) (*Amount, error) {
+	x, y, err := minorUnitsPair(a, b)
+	if err != nil {
+		return nil, err
+	}
+	return amountFromMinorUnits(x-y, a.Currency), nil
+}
+
+// Cmp compares a and b, returning -1 if a < b, 0 if they are equal and +1 if
+// a > b. Both amounts must be in the same currency.
+func (a *Amount) Cmp(b *Amount) (int, error) {
+	x, y, err := minorUnitsPair(a, b)
+	if err != nil {
+		return 0, err
+	}
+	return cmp.Compare(x, y), nil
+}
+
+// InRange reports whether lo <= a <= hi, e.g. to enforce business rules on a
+// payment before creating it. A nil lo or hi leaves that side open. Both
+// bounds must be in a's currency, and lo must not be above hi.
+func (a *Amount) InRange(lo, hi *Amount) (bool, error) {
+	for _, bound := range []*Amount{lo, hi} {
+		if bound != nil && bound.Currency != a.Currency {
+			return false, fmt.Errorf("currency mismatch: %s vs %s", a.Currency, bound.Currency)
+		}
+	}
+	if lo != nil && hi != nil {
+		c, err := lo.Cmp(hi)
+		if err != nil {
+			return false, err
+		}
+		if c > 0 {
+			return false, fmt.Errorf("invalid range: %s %s is above %s %s", lo.Value, lo.Currency, hi.Value, hi.Currency)
+		}
+	}
+	if lo != nil {
+		c, err := a.Cmp(lo)
+		if err != nil {
+			return false, err
+		}
+		if c < 0 {
+			return false, nil
+		}
+	}
+	if hi != nil {
+		c, err := a.Cmp(hi)
+		if err != nil {
+			return false, err
+		}
+		if c > 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func minorUnitsPair(a, b *Amount) (int64, int64, error) {
+	if a.Currency != b.Currency {
+		return 0, 0, fmt.Errorf("currency mismatch: %s vs %s", a.Currency, b.Currency)
+	}
+	x, err := a.MinorUnits()
+	if err != nil {
+		return 0, 0, err
+	}
+	y, err := b.MinorUnits()
+	if err != nil {
+		return 0, 0, err
+	}
+	return x, y, nil
+}
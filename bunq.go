@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // FlexFloat64 is a float64 that can be unmarshaled from both JSON numbers and strings.
@@ -39,11 +42,41 @@ func (f FlexFloat64) MarshalJSON() ([]byte, error) {
 	return json.Marshal(float64(f))
 }
 
-// NewAmount creates an Amount from a float64 value and currency code.
+// FlexInt is an int that can be unmarshaled from both JSON numbers and strings,
+// like FlexFloat64.
+type FlexInt int
+
+func (i *FlexInt) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var n int
+	if err := json.Unmarshal(data, &n); err == nil {
+		*i = FlexInt(n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("FlexInt: cannot unmarshal %s", data)
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("FlexInt: cannot parse %q: %w", s, err)
+	}
+	*i = FlexInt(n)
+	return nil
+}
+
+func (i FlexInt) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int(i))
+}
+
+// NewAmount creates an Amount from a float64 value and currency code. The
+// currency is uppercased, as bunq requires; use Normalize to also validate it.
 func NewAmount(value float64, currency string) *Amount {
 	return &Amount{
 		Value:    strconv.FormatFloat(value, 'f', 2, 64),
-		Currency: currency,
+		Currency: strings.ToUpper(currency),
 	}
 }
 
@@ -64,6 +97,29 @@ var (
 	Sandbox    = Environment{BaseURL: "https://public-api.sandbox.bunq.com/v1"}
 )
 
+// EnvironmentForBaseURL returns the environment with the given base URL,
+// ignoring a trailing slash. API keys only work in the environment they were
+// created for: sandbox keys (prefixed "sandbox_") with Sandbox, all others
+// with Production.
+func EnvironmentForBaseURL(baseURL string) (Environment, bool) {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	for _, env := range []Environment{Production, Sandbox} {
+		if baseURL == env.BaseURL {
+			return env, true
+		}
+	}
+	return Environment{}, false
+}
+
+// checkEnvironment rejects a sandbox API key configured for production, which
+// would otherwise fail later with a less obvious authentication error.
+func checkEnvironment(cfg Config) error {
+	if cfg.Environment == Production && strings.HasPrefix(cfg.APIKey, "sandbox_") {
+		return ErrEnvironmentMismatch
+	}
+	return nil
+}
+
 // Config holds configuration for creating a new Client.
 type Config struct {
 	APIKey      string
@@ -71,13 +127,115 @@ type Config struct {
 	Description string       // device description, defaults to "bunq-go"
 	AllowedIPs  []string     // empty = wildcard (*)
 	HTTPClient  *http.Client // optional, defaults to http.DefaultClient
+	RetryPolicy RetryPolicy  // optional, replaces the built-in 429 retry logic
+	Observer    Observer     // optional, called after every HTTP attempt
+
+	// BootstrapObserver, if set, is called after each step of setting up
+	// the client, with its duration and outcome.
+	BootstrapObserver BootstrapObserver
+
+	// RetryMaxDelay caps the wait between retries of the built-in retry
+	// logic, including waits requested by a Retry-After header. Zero means
+	// 30 seconds, bunq's cooldown after a 429.
+	RetryMaxDelay time.Duration
+
+	// SessionRefreshThreshold is how long before the session expires it is
+	// refreshed. Zero means 30 seconds. Raise it if single operations can
+	// take longer, so the session does not expire halfway. It is capped at
+	// half the session's lifetime, bunq's session_timeout, which users can
+	// set in the app.
+	SessionRefreshThreshold time.Duration
+
+	// SessionRefreshAttempts is how often refreshing an expiring session is
+	// tried when bunq cannot be reached or answers with a server error,
+	// waiting 1s, 2s, 4s... in between. Zero means 3 attempts.
+	SessionRefreshAttempts int
+
+	// PrimaryAccountAttempts is how often NewClient looks for an active
+	// monetary account before giving up, PrimaryAccountPollInterval apart.
+	// The account of a freshly created sandbox user can take a moment to
+	// become active. Zero means 5 attempts, 1 second apart.
+	PrimaryAccountAttempts     int
+	PrimaryAccountPollInterval time.Duration
+
+	// MaxResponseBytes caps the size of a response body read into memory.
+	// Zero means 32 MiB; a negative value disables the limit. Downloads of
+	// binary content such as attachments are not limited.
+	MaxResponseBytes int64
+
+	// EncryptRequests encrypts request bodies sent with the session, for
+	// endpoints that handle sensitive data. Each body is encrypted with a
+	// fresh AES-256 key, which is sent encrypted with bunq's public key in
+	// the X-Bunq-Client-Encryption-* headers. Requests without a body and
+	// the installation and session requests themselves are sent as is.
+	EncryptRequests bool
 }
 
+// RetryPolicy decides whether a failed request is retried. attempt is the
+// zero-based index of the attempt that failed. status is the HTTP status code,
+// or 0 if the request could not be executed; err is the corresponding
+// *APIError or transport error. Returning true retries after delay.
+//
+// A RetryPolicy fully replaces the built-in behavior (retrying 429 responses,
+// and network errors and 502, 503 and 504 responses of repeatable requests, up
+// to 5 times with exponential backoff), so it is responsible for bounding the
+// number of attempts. It is not consulted for network errors and 5xx
+// responses of a POST without an idempotency key (see WithIdempotencyKey),
+// which are never retried.
+type RetryPolicy func(attempt int, status int, err error) (retry bool, delay time.Duration)
+
 // ListOptions controls pagination for list endpoints.
 type ListOptions struct {
 	Count   int
 	OlderID int
 	NewerID int
+
+	// StopBefore, if set, ends iteration at the first item whose Created
+	// timestamp is before it. bunq lists items newest first, so older pages
+	// are not fetched.
+	StopBefore time.Time
+
+	// Filters holds extra, endpoint-specific query parameters. It must not
+	// contain count, older_id or newer_id; use the fields above instead.
+	Filters map[string]string
+
+	// Flags holds boolean query parameters, sent as "true" or "false", such
+	// as ListFlagDisplayUserEvent. Only the flags an endpoint supports are
+	// accepted.
+	Flags map[string]bool
+}
+
+// ListFlagDisplayUserEvent includes events not tied to a monetary account,
+// such as card and user changes, when listing events.
+const ListFlagDisplayUserEvent = "display_user_event"
+
+// listFlags lists, by response key, the boolean query parameters the list
+// endpoints support.
+var listFlags = map[string][]string{
+	"Event": {ListFlagDisplayUserEvent},
+}
+
+// validate rejects Filters that would clash with the pagination parameters,
+// and Flags that endpoint, the response key of the listed type, does not
+// support.
+func (o *ListOptions) validate(endpoint string) error {
+	if o == nil {
+		return nil
+	}
+	for _, key := range []string{"count", "older_id", "newer_id"} {
+		if _, ok := o.Filters[key]; ok {
+			return fmt.Errorf("ListOptions.Filters must not set %q", key)
+		}
+	}
+	for flag := range o.Flags {
+		if !slices.Contains(listFlags[endpoint], flag) {
+			return fmt.Errorf("ListOptions.Flags: %s does not support %q", endpoint, flag)
+		}
+		if _, ok := o.Filters[flag]; ok {
+			return fmt.Errorf("ListOptions: %q is set in both Filters and Flags", flag)
+		}
+	}
+	return nil
 }
 
 func (o *ListOptions) toParams() map[string]string {
@@ -85,6 +243,12 @@ func (o *ListOptions) toParams() map[string]string {
 		return nil
 	}
 	p := map[string]string{}
+	for k, v := range o.Filters {
+		p[k] = v
+	}
+	for k, v := range o.Flags {
+		p[k] = strconv.FormatBool(v)
+	}
 	if o.Count > 0 {
 		p["count"] = fmt.Sprintf("%d", o.Count)
 	}
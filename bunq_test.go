@@ -2,10 +2,31 @@ package bunq
 
 import (
 	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"io"
+	"iter"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -22,6 +43,21 @@ func TestUnmarshalID(t *testing.T) {
 	}
 }
 
+func TestUnmarshalID_String(t *testing.T) {
+	body := `{"Response":[{"Id":{"id":"42"}}]}`
+	id, err := unmarshalID([]byte(body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != 42 {
+		t.Errorf("expected 42, got %d", id)
+	}
+
+	if _, err := unmarshalID([]byte(`{"Response":[{"Id":{"id":"abc"}}]}`)); err == nil {
+		t.Error("expected error for a non-numeric id")
+	}
+}
+
 func TestUnmarshalUUID(t *testing.T) {
 	body := `{"Response":[{"Uuid":{"uuid":"abc-123"}}]}`
 	uuid, err := unmarshalUUID([]byte(body))
@@ -202,6 +238,34 @@ func TestFlexFloat64_MarshalJSON(t *testing.T) {
 	}
 }
 
+func TestUnmarshalObject_NumericRateFields(t *testing.T) {
+	for _, body := range []string{
+		`{"Response":[{"CurrencyConversionQuote":{"id":1,"rate":"1.0852"}}]}`,
+		`{"Response":[{"CurrencyConversionQuote":{"id":1,"rate":1.0852}}]}`,
+	} {
+		q, err := unmarshalObject[CurrencyConversionQuote]([]byte(body), "CurrencyConversionQuote")
+		if err != nil {
+			t.Fatalf("%s: %v", body, err)
+		}
+		if float64(q.Rate) != 1.0852 {
+			t.Errorf("%s: rate = %v, want 1.0852", body, q.Rate)
+		}
+	}
+
+	for _, body := range []string{
+		`{"Response":[{"CashbackPayoutItem":{"id":1,"rate_applied":"0.5"}}],"Pagination":{}}`,
+		`{"Response":[{"CashbackPayoutItem":{"id":1,"rate_applied":0.5}}],"Pagination":{}}`,
+	} {
+		resp, err := unmarshalList[CashbackPayoutItem]([]byte(body), "CashbackPayoutItem")
+		if err != nil {
+			t.Fatalf("%s: %v", body, err)
+		}
+		if float64(resp.Items[0].RateApplied) != 0.5 {
+			t.Errorf("%s: rate_applied = %v, want 0.5", body, resp.Items[0].RateApplied)
+		}
+	}
+}
+
 func TestUnmarshalList_SavingsGoalProgress(t *testing.T) {
 	// Reproduces the real bug: API returns savings_goal_progress as a string
 	body := `{"Response":[{"MonetaryAccountSavings":{"id":123,"savings_goal_progress":"75.50"}}],"Pagination":{}}`
@@ -314,7 +378,7 @@ func TestRetryOn429(t *testing.T) {
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		n := calls.Add(1)
 		if n <= 2 {
-			// Return Retry-After: 0 to avoid slow tests
+			// Retry-After: 0 is ignored in favor of the backoff.
 			w.Header().Set("Retry-After", "0")
 			w.WriteHeader(http.StatusTooManyRequests)
 			fmt.Fprintf(w, `{"Error":[{"error_description":"Too many requests"}]}`)
@@ -329,11 +393,16 @@ func TestRetryOn429(t *testing.T) {
 		httpClient: srv.Client(),
 		baseURL:    srv.URL,
 	}
+	clock := newFakeClock()
+	clock.install(c)
 
 	body, _, err := c.request(context.Background(), http.MethodGet, "test", nil, false)
 	if err != nil {
 		t.Fatalf("expected success after retries, got: %v", err)
 	}
+	if !slices.Equal(clock.slept, []time.Duration{time.Second, 2 * time.Second}) {
+		t.Errorf("expected backoff of 1s and 2s, got %v", clock.slept)
+	}
 
 	id, err := unmarshalID(body)
 	if err != nil {
@@ -361,6 +430,8 @@ func TestRetryOn429_ExhaustsRetries(t *testing.T) {
 		httpClient: srv.Client(),
 		baseURL:    srv.URL,
 	}
+	clock := newFakeClock()
+	clock.install(c)
 
 	_, _, err := c.request(context.Background(), http.MethodGet, "test", nil, false)
 	if err == nil {
@@ -373,38 +444,4300 @@ func TestRetryOn429_ExhaustsRetries(t *testing.T) {
 	if n := calls.Load(); n != 6 {
 		t.Errorf("expected 6 calls (1 + 5 retries), got %d", n)
 	}
+	if total := clock.Now().Sub(fakeClockStart); total != 31*time.Second {
+		t.Errorf("expected 31s of backoff in total, got %v", total)
+	}
 }
 
 func TestRetryOn429_ExponentialBackoff(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 4 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprintf(w, `{"Error":[{"error_description":"Too many requests"}]}`)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"Response":[{"Id":{"id":1}}]}`)
+	}))
+	defer srv.Close()
+
+	c := &Client{
+		httpClient: srv.Client(),
+		baseURL:    srv.URL,
+	}
+	clock := newFakeClock()
+	clock.install(c)
+
+	_, _, err := c.request(context.Background(), http.MethodGet, "test", nil, false)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The backoff doubles: 1s, 2s, 4s.
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second}
+	if !slices.Equal(clock.slept, want) {
+		t.Errorf("expected backoff %v, got %v", want, clock.slept)
+	}
+}
+
+func TestRetryOn429_MaxDelay(t *testing.T) {
 	var timestamps []time.Time
 	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		timestamps = append(timestamps, time.Now())
 		if len(timestamps) < 4 {
+			if len(timestamps) == 1 {
+				w.Header().Set("Retry-After", "30")
+			}
 			w.WriteHeader(http.StatusTooManyRequests)
 			fmt.Fprintf(w, `{"Error":[{"error_description":"Too many requests"}]}`)
 			return
 		}
-		w.WriteHeader(http.StatusOK)
 		fmt.Fprintf(w, `{"Response":[{"Id":{"id":1}}]}`)
 	}))
 	defer srv.Close()
 
 	c := &Client{
+		cfg:        Config{RetryMaxDelay: 50 * time.Millisecond},
+		httpClient: srv.Client(),
+		baseURL:    srv.URL,
+	}
+
+	if _, _, err := c.request(context.Background(), http.MethodGet, "test", nil, false); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(timestamps) != 4 {
+		t.Fatalf("expected 4 calls, got %d", len(timestamps))
+	}
+	// Both the Retry-After of 30s and the backoff of 2s and 4s are capped.
+	for i := 1; i < len(timestamps); i++ {
+		if gap := timestamps[i].Sub(timestamps[i-1]); gap > 500*time.Millisecond {
+			t.Errorf("gap %d: got %v, expected at most ~50ms", i, gap)
+		}
+	}
+}
+
+func TestRetryTransient_ByMethod(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		key       string
+		wantCalls int
+	}{
+		{"GET is retried", http.MethodGet, "", 2},
+		{"PUT is retried", http.MethodPut, "", 2},
+		{"POST without key is not retried", http.MethodPost, "", 1},
+		{"POST with key is retried", http.MethodPost, "order-17", 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requestIDs []string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requestIDs = append(requestIDs, r.Header.Get("X-Bunq-Client-Request-Id"))
+				if len(requestIDs) == 1 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					fmt.Fprint(w, `{"Error":[{"error_description":"Service unavailable"}]}`)
+					return
+				}
+				fmt.Fprint(w, `{"Response":[{"Id":{"id":1}}]}`)
+			}))
+			defer srv.Close()
+
+			c := &Client{
+				cfg:        Config{RetryMaxDelay: time.Millisecond},
+				httpClient: srv.Client(),
+				baseURL:    srv.URL,
+			}
+			ctx := context.Background()
+			if tt.key != "" {
+				ctx = WithIdempotencyKey(ctx, tt.key)
+			}
+
+			_, _, err := c.request(ctx, tt.method, "test", nil, false)
+			if len(requestIDs) != tt.wantCalls {
+				t.Fatalf("expected %d calls, got %d", tt.wantCalls, len(requestIDs))
+			}
+			if tt.wantCalls == 1 {
+				var apiErr *APIError
+				if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+					t.Errorf("expected APIError with status 503, got %T: %v", err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.key != "" && (requestIDs[0] != tt.key || requestIDs[1] != tt.key) {
+				t.Errorf("expected request ID %q on every attempt, got %q", tt.key, requestIDs)
+			}
+			if tt.key == "" && requestIDs[0] == requestIDs[1] {
+				t.Errorf("expected a fresh request ID per attempt, got %q", requestIDs)
+			}
+		})
+	}
+}
+
+func TestIdempotencyKey_OnlyOnKeyedRequest(t *testing.T) {
+	requestIDs := map[string][]string{}
+	var gets atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := r.Method + " " + r.URL.Path
+		requestIDs[call] = append(requestIDs[call], r.Header.Get("X-Bunq-Client-Request-Id"))
+		switch {
+		case r.URL.Path == "/session-server":
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":1}},{"Token":{"token":"refreshed"}},{"UserPerson":{"id":1}}]}`)
+		case r.Method == http.MethodPost:
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":8}}]}`)
+		default:
+			status := "PENDING"
+			if gets.Add(1) >= 2 {
+				status = "ACCEPTED"
+			}
+			fmt.Fprintf(w, `{"Response":[{"Payment":{"id":8,"bunqto_status":%q}}]}`, status)
+		}
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	newFakeClock().install(c)
+	c.sessionExpiry = time.Time{} // force a session refresh first
+	ctx := WithIdempotencyKey(context.Background(), "order-17")
+	if _, err := c.SendPayment(ctx, 0, PaymentCreateParams{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := requestIDs["POST /user/1/monetary-account/2/payment"]; len(got) != 1 || got[0] != "order-17" {
+		t.Errorf("payment POST request IDs = %q, want the key", got)
+	}
+	for _, call := range []string{"POST /session-server", "GET /user/1/monetary-account/2/payment/8"} {
+		ids := requestIDs[call]
+		if len(ids) == 0 {
+			t.Errorf("expected %s", call)
+		}
+		for _, id := range ids {
+			if id == "order-17" {
+				t.Errorf("%s was sent with the idempotency key", call)
+			}
+		}
+	}
+}
+
+func TestRetryPolicy_PostWithoutKeyNotConsulted(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.Close()
+
+	var consulted bool
+	c := &Client{
+		cfg: Config{
+			RetryPolicy: func(attempt, status int, err error) (bool, time.Duration) {
+				consulted = true
+				return attempt < 2, 0
+			},
+		},
+		httpClient: srv.Client(),
+		baseURL:    srv.URL,
+	}
+
+	if _, _, err := c.request(context.Background(), http.MethodPost, "test", struct{}{}, false); err == nil {
+		t.Fatal("expected error")
+	}
+	if consulted {
+		t.Error("expected the policy not to be consulted for a POST without idempotency key")
+	}
+}
+
+// fakeClockStart is the time a fakeClock starts at.
+var fakeClockStart = time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+
+// fakeClock is a manual clock for a Client: sleeping advances it at once.
+type fakeClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	slept []time.Duration
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: fakeClockStart}
+}
+
+func (f *fakeClock) install(c *Client) {
+	c.nowFunc = f.Now
+	c.sleepFunc = f.Sleep
+}
+
+func (f *fakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+func (f *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.slept = append(f.slept, d)
+	f.now = f.now.Add(d)
+	return nil
+}
+
+func (f *fakeClock) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+func TestSessionExpiry_FakeClock(t *testing.T) {
+	var tokens []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/session-server" {
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":1}},{"Token":{"token":"refreshed"}},{"UserPerson":{"id":1,"session_timeout":120}}]}`)
+			return
+		}
+		tokens = append(tokens, r.Header.Get("X-Bunq-Client-Authentication"))
+		fmt.Fprint(w, `{"Response":[{"Card":{"id":3}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	clock := newFakeClock()
+	clock.install(c)
+	c.sessionExpiry = clock.Now().Add(time.Minute)
+	ctx := context.Background()
+
+	get := func() {
+		t.Helper()
+		if _, err := c.Card.Get(ctx, 3); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	get()
+	clock.Advance(29 * time.Second) // 31s left, more than the 30s threshold
+	get()
+	clock.Advance(time.Second) // 30s left: refresh
+	get()
+
+	want := []string{"test-session", "test-session", "refreshed"}
+	if !slices.Equal(tokens, want) {
+		t.Errorf("expected tokens %q, got %q", want, tokens)
+	}
+	if want := clock.Now().Add(2 * time.Minute); !c.sessionExpiry.Equal(want) {
+		t.Errorf("expected new expiry %v, got %v", want, c.sessionExpiry)
+	}
+}
+
+// newMockClient returns a Client with an active fake session that talks to srv.
+func newMockClient(srv *httptest.Server) *Client {
+	c := &Client{
+		httpClient:               srv.Client(),
+		baseURL:                  srv.URL,
+		sessionToken:             "test-session",
+		sessionExpiry:            time.Now().Add(time.Hour),
+		userID:                   1,
+		primaryMonetaryAccountID: 2,
+	}
+	c.initServices()
+	c.initCustomServices()
+	return c
+}
+
+func TestUUIDPathParam(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		fmt.Fprint(w, `{"Response":[{"AttachmentPublic":{"uuid":"9f4c6a2e-3b1d-4e8a-9c7f-5d2b1a0e6f3c"}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+
+	if _, err := c.AttachmentPublic.Get(ctx, "9f4c6a2e-3b1d-4e8a-9c7f-5d2b1a0e6f3c"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.AttachmentPublic.Get(ctx, "../user/1"); err == nil {
+		t.Error("expected error for malformed UUID")
+	}
+	for _, err := range c.AttachmentPublicContent.List(ctx, "not-a-uuid", nil) {
+		if err == nil {
+			t.Error("expected error for malformed UUID")
+		}
+	}
+	if len(paths) != 1 || paths[0] != "/attachment-public/9f4c6a2e-3b1d-4e8a-9c7f-5d2b1a0e6f3c" {
+		t.Errorf("expected a single valid request, got %v", paths)
+	}
+}
+
+func TestCreateAndFetch(t *testing.T) {
+	var methods []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case http.MethodPost:
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":7}}]}`)
+		case http.MethodGet:
+			fmt.Fprint(w, `{"Response":[{"Payment":{"id":7,"description":"lunch"}}]}`)
+		}
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	payment, err := c.Payment.CreateAndFetch(context.Background(), 0, PaymentCreateParams{
+		Amount:      NewAmount(1, "EUR"),
+		Description: "lunch",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payment.ID != 7 || payment.Description != "lunch" {
+		t.Errorf("unexpected payment: %+v", payment)
+	}
+	want := []string{
+		"POST /user/1/monetary-account/2/payment",
+		"GET /user/1/monetary-account/2/payment/7",
+	}
+	if fmt.Sprint(methods) != fmt.Sprint(want) {
+		t.Errorf("expected calls %v, got %v", want, methods)
+	}
+}
+
+func TestPaymentNotes(t *testing.T) {
+	type call struct {
+		method, path string
+		body         map[string]any
+	}
+	var calls []call
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c := call{method: r.Method, path: r.URL.Path}
+		json.NewDecoder(r.Body).Decode(&c.body)
+		calls = append(calls, c)
+		switch r.Method {
+		case http.MethodPost:
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":11}}]}`)
+		case http.MethodGet:
+			fmt.Fprint(w, `{"Response":[{"NoteText":{"id":11,"content":"invoice 2024-001"}}],"Pagination":{}}`)
+		}
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+
+	id, err := c.NoteTextPayment.Create(ctx, 0, 5, NoteTextPaymentCreateParams{Content: "invoice 2024-001"})
+	if err != nil {
+		t.Fatalf("creating note text: %v", err)
+	}
+	if id != 11 {
+		t.Errorf("expected ID 11, got %d", id)
+	}
+
+	var notes []NoteTextPayment
+	for n, err := range c.NoteTextPayment.List(ctx, 0, 5, nil) {
+		if err != nil {
+			t.Fatalf("listing note texts: %v", err)
+		}
+		notes = append(notes, n)
+	}
+	if len(notes) != 1 || notes[0].Content != "invoice 2024-001" {
+		t.Errorf("unexpected notes: %+v", notes)
+	}
+
+	if _, err := c.NoteAttachmentPayment.Create(ctx, 3, 5, NoteAttachmentPaymentCreateParams{
+		Description:  "receipt",
+		AttachmentID: 99,
+	}); err != nil {
+		t.Fatalf("creating note attachment: %v", err)
+	}
+
+	if len(calls) != 3 {
+		t.Fatalf("expected 3 calls, got %d", len(calls))
+	}
+	if calls[0].method != http.MethodPost || calls[0].path != "/user/1/monetary-account/2/payment/5/note-text" {
+		t.Errorf("unexpected create call: %s %s", calls[0].method, calls[0].path)
+	}
+	if calls[0].body["content"] != "invoice 2024-001" {
+		t.Errorf("unexpected create body: %v", calls[0].body)
+	}
+	if calls[1].method != http.MethodGet || calls[1].path != "/user/1/monetary-account/2/payment/5/note-text" {
+		t.Errorf("unexpected list call: %s %s", calls[1].method, calls[1].path)
+	}
+	if calls[2].path != "/user/1/monetary-account/3/payment/5/note-attachment" {
+		t.Errorf("unexpected attachment path: %s", calls[2].path)
+	}
+	if calls[2].body["description"] != "receipt" || calls[2].body["attachment_id"] != float64(99) {
+		t.Errorf("unexpected attachment body: %v", calls[2].body)
+	}
+}
+
+func TestRetryPolicy(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"Error":[{"error_description":"try again"}]}`)
+	}))
+	defer srv.Close()
+
+	var seen []int
+	c := &Client{
+		cfg: Config{
+			RetryPolicy: func(attempt, status int, err error) (bool, time.Duration) {
+				seen = append(seen, status)
+				var badReq *BadRequestError
+				if !isErr(err, &badReq) {
+					t.Errorf("expected BadRequestError, got %T", err)
+				}
+				return attempt == 0 && status == http.StatusBadRequest, 0
+			},
+		},
 		httpClient: srv.Client(),
 		baseURL:    srv.URL,
 	}
 
 	_, _, err := c.request(context.Background(), http.MethodGet, "test", nil, false)
+	var badReq *BadRequestError
+	if !isErr(err, &badReq) {
+		t.Fatalf("expected BadRequestError, got %T: %v", err, err)
+	}
+	if n := calls.Load(); n != 2 {
+		t.Errorf("expected 2 calls (1 + 1 retry), got %d", n)
+	}
+	if fmt.Sprint(seen) != "[400 400]" {
+		t.Errorf("expected policy to see [400 400], got %v", seen)
+	}
+}
+
+func TestRetryPolicy_TransportError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	srv.Close()
+
+	var attempts int
+	c := &Client{
+		cfg: Config{
+			RetryPolicy: func(attempt, status int, err error) (bool, time.Duration) {
+				attempts++
+				if status != 0 || err == nil {
+					t.Errorf("expected transport error with status 0, got %d, %v", status, err)
+				}
+				return attempt < 2, 0
+			},
+		},
+		httpClient: srv.Client(),
+		baseURL:    srv.URL,
+	}
+
+	if _, _, err := c.request(context.Background(), http.MethodGet, "test", nil, false); err == nil {
+		t.Fatal("expected error")
+	}
+	if attempts != 3 {
+		t.Errorf("expected policy to be consulted 3 times, got %d", attempts)
+	}
+}
+
+func TestTransportWithPinnedCert(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	defer srv.Close()
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	transport, err := TransportWithPinnedCert([][]byte{certPEM})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
+	httpClient := &http.Client{Transport: transport}
 
-	// Verify exponential backoff: gaps should roughly double (1s, 2s, 4s)
-	for i := 1; i < len(timestamps); i++ {
-		gap := timestamps[i].Sub(timestamps[i-1])
-		expected := time.Second << (i - 1)
-		if gap < expected/2 || gap > expected*2 {
-			t.Errorf("gap %d: got %v, expected ~%v", i, gap, expected)
+	resp, err := httpClient.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("expected pinned server to be trusted: %v", err)
+	}
+	resp.Body.Close()
+
+	// The pinned pool is the only trust anchor, so pinning any other
+	// certificate must reject the server.
+	key, err := generateRSAKeyPair()
+	if err != nil {
+		t.Fatalf("keygen: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "other"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+	otherPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	transport, err = TransportWithPinnedCert([][]byte{otherPEM})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := (&http.Client{Transport: transport}).Get(srv.URL); err == nil {
+		t.Error("expected server with unpinned certificate to be rejected")
+	}
+
+	if _, err := TransportWithPinnedCert([][]byte{[]byte("not a cert")}); err == nil {
+		t.Error("expected error for invalid PEM")
+	}
+	if _, err := TransportWithPinnedCert(nil); err == nil {
+		t.Error("expected error for empty pin set")
+	}
+}
+
+func TestCashRegister(t *testing.T) {
+	var createBody map[string]any
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		switch r.Method {
+		case http.MethodPost:
+			json.NewDecoder(r.Body).Decode(&createBody)
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":31}}]}`)
+		case http.MethodGet:
+			fmt.Fprint(w, `{"Response":[{"CashRegister":{"id":31,"name":"Till 1","status":"PENDING_APPROVAL","avatar":{"uuid":"av-1"}}}],"Pagination":{}}`)
+		}
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+
+	id, err := c.CashRegister.Create(ctx, 0, CashRegisterCreateParams{
+		Name:       "Till 1",
+		Status:     "PENDING_APPROVAL",
+		AvatarUUID: "av-1",
+	})
+	if err != nil {
+		t.Fatalf("creating cash register: %v", err)
+	}
+	if id != 31 {
+		t.Errorf("expected ID 31, got %d", id)
+	}
+	if createBody["name"] != "Till 1" || createBody["status"] != "PENDING_APPROVAL" || createBody["avatar_uuid"] != "av-1" {
+		t.Errorf("unexpected create body: %v", createBody)
+	}
+
+	var registers []CashRegister
+	for r, err := range c.CashRegister.List(ctx, 0, nil) {
+		if err != nil {
+			t.Fatalf("listing cash registers: %v", err)
+		}
+		registers = append(registers, r)
+	}
+	if len(registers) != 1 || registers[0].Name != "Till 1" || registers[0].Avatar.UUID != "av-1" {
+		t.Errorf("unexpected registers: %+v", registers)
+	}
+
+	want := "[POST /user/1/monetary-account/2/cash-register GET /user/1/monetary-account/2/cash-register]"
+	if fmt.Sprint(paths) != want {
+		t.Errorf("expected calls %s, got %v", want, paths)
+	}
+}
+
+func TestEndpointInfo(t *testing.T) {
+	meta, ok := EndpointInfo("Payment")
+	if !ok {
+		t.Fatal("expected Payment to be registered")
+	}
+	want := EndpointMeta{
+		Create: "user/{}/monetary-account/{}/payment",
+		Read:   "user/{}/monetary-account/{}/payment/{}",
+		List:   "user/{}/monetary-account/{}/payment",
+	}
+	if meta != want {
+		t.Errorf("expected %+v, got %+v", want, meta)
+	}
+
+	if _, ok := EndpointInfo("Amount"); ok {
+		t.Error("expected Amount (no service) to be absent")
+	}
+}
+
+func TestEndpoints(t *testing.T) {
+	eps := Endpoints()
+	for _, want := range []Endpoint{
+		{"Payment", http.MethodPost, "user/{}/monetary-account/{}/payment"},
+		{"Payment", http.MethodGet, "user/{}/monetary-account/{}/payment/{}"},
+		{"Payment", http.MethodGet, "user/{}/monetary-account/{}/payment"},
+		{"CustomerLimit", http.MethodGet, "user/{}/limit"},
+	} {
+		if !slices.Contains(eps, want) {
+			t.Errorf("missing endpoint %+v", want)
+		}
+	}
+	if !slices.IsSortedFunc(eps, func(a, b Endpoint) int { return strings.Compare(a.Template, b.Template) }) {
+		t.Error("expected endpoints sorted by template")
+	}
+
+	ep := Endpoint{"Payment", http.MethodGet, "user/{}/monetary-account/{}/payment/{}"}
+	for path, want := range map[string]bool{
+		"/user/1/monetary-account/2/payment/3": true,
+		"user/1/monetary-account/2/payment/3":  true,
+		"/user/1/monetary-account/2/payment":   false,
+		"/user/1/monetary-account//payment/3":  false,
+		"/user/1/monetary-account/2/request/3": false,
+	} {
+		if got := ep.Match(path); got != want {
+			t.Errorf("Match(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
+
+func TestNewAPIError_FieldErrors(t *testing.T) {
+	body := `{"Error":[{"error_description":"Amount must be positive","field":"amount"},{"error_description":"Something else went wrong"}]}`
+	err := newAPIError(400, "resp-456", []byte(body))
+
+	var badReq *BadRequestError
+	if !isErr(err, &badReq) {
+		t.Fatalf("expected BadRequestError, got %T", err)
+	}
+	if len(badReq.Messages) != 2 {
+		t.Errorf("expected 2 messages, got %d", len(badReq.Messages))
+	}
+	want := []FieldError{{Field: "amount", Message: "Amount must be positive"}}
+	if fmt.Sprint(badReq.FieldErrors) != fmt.Sprint(want) {
+		t.Errorf("expected field errors %v, got %v", want, badReq.FieldErrors)
+	}
+
+	// Flat error bodies carry no field detail.
+	err = newAPIError(400, "resp-789", []byte(`{"Error":[{"error_description":"bad request"}]}`))
+	if !isErr(err, &badReq) {
+		t.Fatalf("expected BadRequestError, got %T", err)
+	}
+	if badReq.FieldErrors != nil {
+		t.Errorf("expected no field errors, got %v", badReq.FieldErrors)
+	}
+}
+
+func TestSendPayment(t *testing.T) {
+	var gets atomic.Int32
+	getStatus := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":8}}]}`)
+		case http.MethodGet:
+			if getStatus != http.StatusOK {
+				w.WriteHeader(getStatus)
+				fmt.Fprint(w, `{"Error":[{"error_description":"Maintenance"}]}`)
+				return
+			}
+			status := "PENDING"
+			if gets.Add(1) >= 3 {
+				status = "ACCEPTED"
+			}
+			fmt.Fprintf(w, `{"Response":[{"Payment":{"id":8,"bunqto_status":%q}}]}`, status)
+		}
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	clock := newFakeClock()
+	clock.install(c)
+	payment, err := c.SendPayment(context.Background(), 0, PaymentCreateParams{Amount: NewAmount(1, "EUR")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payment.ID != 8 || payment.BunqtoStatus != "ACCEPTED" {
+		t.Errorf("unexpected payment: %+v", payment)
+	}
+	if n := gets.Load(); n != 3 {
+		t.Errorf("expected 3 polls, got %d", n)
+	}
+	if want := []time.Duration{time.Second, time.Second}; !slices.Equal(clock.slept, want) {
+		t.Errorf("slept %v, want %v", clock.slept, want)
+	}
+
+	// A payment that never settles is bounded by the context.
+	gets.Store(-1 << 30)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	payment, err = c.SendPayment(ctx, 0, PaymentCreateParams{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if payment == nil || payment.BunqtoStatus != "PENDING" {
+		t.Errorf("expected the last pending payment, got %+v", payment)
+	}
+
+	// If the payment was created but could not be fetched, its ID is still
+	// returned.
+	getStatus = http.StatusServiceUnavailable
+	payment, err = c.SendPayment(context.Background(), 0, PaymentCreateParams{})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if payment == nil || payment.ID != 8 {
+		t.Errorf("expected the created payment ID with the error, got %+v", payment)
+	}
+}
+
+func TestPing(t *testing.T) {
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health-check" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("X-Bunq-Client-Authentication") != "" {
+			t.Error("expected no authentication header")
+		}
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			fmt.Fprint(w, `{"Error":[{"error_description":"Maintenance"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"Response":[{"HealthCheckResult":{"status":"OK"}}]}`)
+	}))
+	defer srv.Close()
+
+	env := Environment{BaseURL: srv.URL}
+	if err := Ping(context.Background(), env, srv.Client()); err != nil {
+		t.Fatalf("expected healthy ping, got %v", err)
+	}
+
+	status = http.StatusServiceUnavailable
+	err := Ping(context.Background(), env, srv.Client())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected APIError with status 503, got %T: %v", err, err)
+	}
+
+	srv.Close()
+	if err := Ping(context.Background(), env, srv.Client()); err == nil {
+		t.Error("expected error for unreachable server")
+	}
+}
+
+func TestRetryOn429_ContextCanceledDuringBackoff(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"Error":[{"error_description":"Too many requests"}]}`)
+	}))
+	defer srv.Close()
+
+	c := &Client{
+		httpClient: srv.Client(),
+		baseURL:    srv.URL,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, _, err := c.request(ctx, http.MethodGet, "test", nil, false)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected prompt return after cancel, took %v", elapsed)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("expected 1 call before cancellation, got %d", n)
+	}
+}
+
+func TestRetryOn429_DeadlineShorterThanBackoff(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{"Error":[{"error_description":"Too many requests"}]}`)
+	}))
+	defer srv.Close()
+
+	c := &Client{
+		httpClient: srv.Client(),
+		baseURL:    srv.URL,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err := c.request(ctx, http.MethodGet, "test", nil, false)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected failure before the deadline, took %v", elapsed)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("expected 1 call, got %d", n)
+	}
+}
+
+func TestPaymentCreateParams_MerchantReferenceAndAttachment(t *testing.T) {
+	params := PaymentCreateParams{
+		Amount:            NewAmount(25, "EUR"),
+		Description:       "order 1234",
+		MerchantReference: "ORDER-1234",
+		Attachment:        []*AttachmentMonetaryAccountPayment{{ID: 77}},
+	}
+	b, err := json.Marshal(params)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	expected := `{"amount":{"value":"25.00","currency":"EUR"},"description":"order 1234","attachment":[{"id":77}],"merchant_reference":"ORDER-1234"}`
+	if string(b) != expected {
+		t.Errorf("expected %s, got %s", expected, b)
+	}
+}
+
+func TestCurrentUser(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/1" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"Response":[{"UserPerson":{"id":1,"display_name":"Jan Jansen","public_nick_name":"Jan","status":"ACTIVE","address_main":{"city":"Amsterdam"},"avatar":{"uuid":"av-9"}}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	user, err := c.CurrentUser(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if user.ID != 1 || user.Type != "UserPerson" || user.DisplayName != "Jan Jansen" || user.PublicNickName != "Jan" || user.Status != "ACTIVE" {
+		t.Errorf("unexpected user: %+v", user)
+	}
+	if user.Address == nil || user.Address.City != "Amsterdam" {
+		t.Errorf("expected address in Amsterdam, got %+v", user.Address)
+	}
+	if user.Avatar == nil || user.Avatar.UUID != "av-9" {
+		t.Errorf("expected avatar av-9, got %+v", user.Avatar)
+	}
+	if user.User.UserPerson == nil {
+		t.Error("expected raw UserPerson to be set")
+	}
+}
+
+func TestAmountCurrency(t *testing.T) {
+	if a := NewAmount(5, "eur"); a.Currency != "EUR" {
+		t.Errorf("NewAmount: expected EUR, got %s", a.Currency)
+	}
+
+	a, err := NewAmountFromString("12.5", "eur")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if a.Value != "12.50" || a.Currency != "EUR" {
+		t.Errorf("expected 12.50 EUR, got %s %s", a.Value, a.Currency)
+	}
+	for _, tt := range []struct{ value, currency string }{
+		{"12.50", "EURO"},
+		{"12.50", "EU"},
+		{"12.50", "E1R"},
+		{"12.505", "EUR"},
+	} {
+		if _, err := NewAmountFromString(tt.value, tt.currency); err == nil {
+			t.Errorf("NewAmountFromString(%q, %q): expected error", tt.value, tt.currency)
+		}
+	}
+
+	b := &Amount{Value: "1.00", Currency: "usd"}
+	if err := b.Normalize(); err != nil || b.Currency != "USD" {
+		t.Errorf("Normalize: got %s, %v", b.Currency, err)
+	}
+	c := &Amount{Value: "1.00", Currency: "EURO"}
+	if err := c.Normalize(); err == nil || c.Currency != "EURO" {
+		t.Errorf("Normalize: expected error leaving EURO unchanged, got %s, %v", c.Currency, err)
+	}
+}
+
+func TestAmountArithmetic(t *testing.T) {
+	a := &Amount{Value: "10.10", Currency: "EUR"}
+	b := &Amount{Value: "0.2", Currency: "EUR"}
+
+	sum, err := a.Add(b)
+	if err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if sum.Value != "10.30" || sum.Currency != "EUR" {
+		t.Errorf("expected 10.30 EUR, got %s %s", sum.Value, sum.Currency)
+	}
+
+	diff, err := b.Sub(a)
+	if err != nil {
+		t.Fatalf("sub: %v", err)
+	}
+	if diff.Value != "-9.90" {
+		t.Errorf("expected -9.90, got %s", diff.Value)
+	}
+
+	if _, err := a.Add(&Amount{Value: "1.00", Currency: "USD"}); err == nil {
+		t.Error("expected currency mismatch error")
+	}
+	if _, err := (&Amount{Value: "1.234", Currency: "EUR"}).MinorUnits(); err == nil {
+		t.Error("expected error for three decimals")
+	}
+	if n, err := (&Amount{Value: "-0.05"}).MinorUnits(); err != nil || n != -5 {
+		t.Errorf("expected -5, got %d (%v)", n, err)
+	}
+}
+
+func TestAmountInRange(t *testing.T) {
+	lo := &Amount{Value: "1.00", Currency: "EUR"}
+	hi := &Amount{Value: "10000.00", Currency: "EUR"}
+
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"1.00", true},
+		{"250.5", true},
+		{"10000.00", true},
+		{"0.99", false},     // below min
+		{"10000.01", false}, // above max
+	}
+	for _, tt := range tests {
+		got, err := (&Amount{Value: tt.value, Currency: "EUR"}).InRange(lo, hi)
+		if err != nil {
+			t.Errorf("InRange(%s): %v", tt.value, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("InRange(%s) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+
+	if ok, err := (&Amount{Value: "0.01", Currency: "EUR"}).InRange(nil, hi); err != nil || !ok {
+		t.Errorf("expected an open minimum, got %v (%v)", ok, err)
+	}
+	if _, err := (&Amount{Value: "5.00", Currency: "USD"}).InRange(lo, hi); err == nil {
+		t.Error("expected currency mismatch error")
+	}
+	// A bound in another currency is an error even if the other bound
+	// already decides the result.
+	if _, err := (&Amount{Value: "0.50", Currency: "EUR"}).InRange(lo, &Amount{Value: "10.00", Currency: "USD"}); err == nil {
+		t.Error("expected currency mismatch error for the upper bound")
+	}
+	if _, err := (&Amount{Value: "5.00", Currency: "EUR"}).InRange(hi, lo); err == nil {
+		t.Error("expected an error for a lower bound above the upper bound")
+	}
+	if c, err := lo.Cmp(hi); err != nil || c != -1 {
+		t.Errorf("Cmp = %d (%v), want -1", c, err)
+	}
+}
+
+func TestAmountDecimal(t *testing.T) {
+	a, err := (&Amount{Value: "0.1", Currency: "EUR"}).Decimal()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err := (&Amount{Value: "0.2", Currency: "EUR"}).Decimal()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := big.NewRat(3, 10)
+	if sum := new(big.Rat).Add(a, b); sum.Cmp(want) != 0 {
+		t.Errorf("expected 0.1 + 0.2 = 3/10, got %s", sum)
+	}
+	// float64 gets this wrong.
+	if x, y := (&Amount{Value: "0.1"}).Float64(), (&Amount{Value: "0.2"}).Float64(); x+y == 0.3 {
+		t.Error("expected float64 addition to be inexact")
+	}
+
+	if r, err := (&Amount{Value: "-12.345678"}).Decimal(); err != nil || r.Cmp(big.NewRat(-12345678, 1000000)) != 0 {
+		t.Errorf("expected -12.345678, got %v (%v)", r, err)
+	}
+	for _, v := range []string{"", "1/3", "1e5", "abc", "-", ".5"} {
+		if _, err := (&Amount{Value: v}).Decimal(); err == nil {
+			t.Errorf("expected error for %q", v)
+		}
+	}
+}
+
+func TestMonetaryAccountBank_AvailableToSpend(t *testing.T) {
+	body := `{"Response":[{"MonetaryAccountBank":{"id":2,"balance":{"value":"-20.00","currency":"EUR"},"overdraft_limit":{"value":"500.00","currency":"EUR"}}}]}`
+	account, err := unmarshalObject[MonetaryAccountBank]([]byte(body), "MonetaryAccountBank")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	available, err := account.AvailableToSpend()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if available.Value != "480.00" || available.Currency != "EUR" {
+		t.Errorf("expected 480.00 EUR, got %s %s", available.Value, available.Currency)
+	}
+
+	// Without an overdraft the balance is all there is.
+	account.OverdraftLimit = nil
+	available, err = account.AvailableToSpend()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if available.Value != "-20.00" {
+		t.Errorf("expected -20.00, got %s", available.Value)
+	}
+}
+
+func TestQRContent(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\nfake")
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "image/png")
+		w.Write(png)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+
+	tabUUID := "3f6c2a9e-8f1b-4c1e-9d2a-5b7e0c4d1a2f"
+	data, contentType, err := c.QR.Content(ctx, TabQR{CashRegisterID: 4, TabUUID: tabUUID})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != string(png) {
+		t.Errorf("unexpected content %q", data)
+	}
+	if contentType != "image/png" {
+		t.Errorf("expected image/png, got %s", contentType)
+	}
+
+	if _, _, err := c.QR.Content(ctx, CashRegisterQR{MonetaryAccountID: 3, CashRegisterID: 4, QRCodeID: 5}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := c.QR.Content(ctx, RequestInquiryQR{RequestInquiryID: 6}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := c.QR.Content(ctx, TabQR{CashRegisterID: 4, TabUUID: "../4"}); err == nil {
+		t.Error("expected an error for an invalid tab UUID")
+	}
+
+	want := "[/user/1/monetary-account/2/cash-register/4/tab/" + tabUUID + "/qr-code-content /user/1/monetary-account/3/cash-register/4/qr-code/5/content /user/1/monetary-account/2/request-inquiry/6/qr-code-content]"
+	if fmt.Sprint(paths) != want {
+		t.Errorf("expected paths %s, got %v", want, paths)
+	}
+}
+
+func TestPaymentRefund(t *testing.T) {
+	var path string
+	var body map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.Method + " " + r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body)
+		fmt.Fprint(w, `{"Response":[{"Id":{"id":12}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	id, err := c.Payment.Refund(context.Background(), 0, 9, PaymentRefundParams{
+		Amount:      NewAmount(5, "EUR"),
+		Description: "partial refund",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != 12 {
+		t.Errorf("expected ID 12, got %d", id)
+	}
+	if path != "POST /user/1/monetary-account/2/payment/9/refund" {
+		t.Errorf("unexpected call %s", path)
+	}
+	amount, _ := body["amount"].(map[string]any)
+	if amount["value"] != "5.00" || amount["currency"] != "EUR" || body["description"] != "partial refund" {
+		t.Errorf("unexpected body: %v", body)
+	}
+}
+
+func TestReferenceFetchers(t *testing.T) {
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		fmt.Fprint(w, `{"Response":[{"Card":{"id":44,"status":"ACTIVE"}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	action := &MasterCardAction{ID: 1, CardID: 44}
+	card, err := action.FetchCard(context.Background(), c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if card.ID != 44 || card.Status != "ACTIVE" {
+		t.Errorf("unexpected card: %+v", card)
+	}
+	if path != "/user/1/card/44" {
+		t.Errorf("unexpected path %s", path)
+	}
+
+	// A zero ID must not silently fall back to e.g. the primary account.
+	if _, err := (&Payment{}).FetchMonetaryAccount(context.Background(), c); err == nil {
+		t.Error("expected error for unset monetary_account_id")
+	}
+}
+
+func TestUnmarshalObject_EmptyAndMalformed(t *testing.T) {
+	_, err := unmarshalObject[Payment]([]byte(`{"Response":[]}`), "Payment")
+	if !errors.Is(err, ErrNoResult) {
+		t.Errorf("expected ErrNoResult for empty array, got %v", err)
+	}
+	if _, err := unmarshalID([]byte(`{"Response":[]}`)); !errors.Is(err, ErrNoResult) {
+		t.Errorf("expected ErrNoResult from unmarshalID, got %v", err)
+	}
+
+	for _, body := range []string{`{}`, `{"Response":null}`, `not json`, `{"Response":[{"Other":{}}]}`} {
+		_, err := unmarshalObject[Payment]([]byte(body), "Payment")
+		if err == nil {
+			t.Errorf("%s: expected error", body)
+			continue
+		}
+		if errors.Is(err, ErrNoResult) {
+			t.Errorf("%s: expected malformed-envelope error, got ErrNoResult", body)
+		}
+	}
+}
+
+// writePythonContext writes an API context file in the Python SDK's format.
+func writePythonContext(t *testing.T, sessionExpiry time.Time) string {
+	t.Helper()
+	clientKey, err := generateRSAKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverKey, err := generateRSAKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(clientKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+	ctxJSON, err := json.Marshal(map[string]any{
+		"environment_type": "SANDBOX",
+		"api_key":          "sandbox_key",
+		"session_context": map[string]any{
+			"token":       "py-session",
+			"expiry_time": sessionExpiry.Format(pythonTimeLayout),
+			"user_id":     1,
+		},
+		"installation_context": map[string]any{
+			"token":              "py-installation",
+			"private_key_client": privPEM,
+			"public_key_client":  publicKeyToPEM(&clientKey.PublicKey),
+			"public_key_server":  publicKeyToPEM(&serverKey.PublicKey),
+		},
+		"proxy_url": nil,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "bunq-sandbox.conf")
+	if err := os.WriteFile(path, ctxJSON, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestNewClientFromPythonContext(t *testing.T) {
+	var sessionCalls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/session-server":
+			sessionCalls.Add(1)
+			if got := r.Header.Get("X-Bunq-Client-Authentication"); got != "py-installation" {
+				t.Errorf("session-server auth = %q, want installation token", got)
+			}
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":9}},{"Token":{"token":"fresh-session"}},{"UserPerson":{"id":1,"session_timeout":3600}}]}`)
+		case "/user/1/monetary-account":
+			fmt.Fprint(w, `{"Response":[{"MonetaryAccountBank":{"id":7,"status":"ACTIVE"}}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	cfg := Config{Environment: Environment{BaseURL: srv.URL}, HTTPClient: srv.Client()}
+
+	t.Run("valid session", func(t *testing.T) {
+		path := writePythonContext(t, time.Now().Add(time.Hour))
+		c, err := NewClientFromPythonContext(context.Background(), path, cfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if sessionCalls.Load() != 0 {
+			t.Errorf("expected no session-server call, got %d", sessionCalls.Load())
+		}
+		if c.sessionToken != "py-session" {
+			t.Errorf("expected saved session token, got %q", c.sessionToken)
+		}
+		if c.cfg.APIKey != "sandbox_key" {
+			t.Errorf("expected API key from context, got %q", c.cfg.APIKey)
+		}
+		if c.UserID() != 1 || c.PrimaryMonetaryAccountID() != 7 {
+			t.Errorf("got user %d account %d, want 1 and 7", c.UserID(), c.PrimaryMonetaryAccountID())
+		}
+		if c.Payment == nil || c.QR == nil {
+			t.Error("expected services to be initialized")
+		}
+	})
+
+	t.Run("expired session", func(t *testing.T) {
+		path := writePythonContext(t, time.Now().Add(-time.Hour))
+		c, err := NewClientFromPythonContext(context.Background(), path, cfg)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if sessionCalls.Load() != 1 {
+			t.Errorf("expected 1 session-server call, got %d", sessionCalls.Load())
+		}
+		if c.sessionToken != "fresh-session" {
+			t.Errorf("expected fresh session token, got %q", c.sessionToken)
+		}
+	})
+}
+
+func TestExportPythonContext_RoundTrip(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/1/monetary-account" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{"Response":[{"MonetaryAccountBank":{"id":7,"status":"ACTIVE"}}]}`)
+	}))
+	defer srv.Close()
+
+	cfg := Config{Environment: Environment{BaseURL: srv.URL}, HTTPClient: srv.Client()}
+	orig, err := NewClientFromPythonContext(context.Background(), writePythonContext(t, time.Now().Add(time.Hour)), cfg)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+
+	data, err := orig.ExportPythonContext()
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("exported context is not JSON: %v", err)
+	}
+	if raw["environment_type"] != "SANDBOX" {
+		t.Errorf("environment_type = %v, want SANDBOX", raw["environment_type"])
+	}
+
+	path := filepath.Join(t.TempDir(), "exported.conf")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewClientFromPythonContext(context.Background(), path, cfg)
+	if err != nil {
+		t.Fatalf("re-import: %v", err)
+	}
+
+	if c.installationToken != orig.installationToken || c.sessionToken != orig.sessionToken {
+		t.Error("tokens did not survive the round trip")
+	}
+	if !c.privateKey.Equal(orig.privateKey) || !c.serverPublicKey.Equal(orig.serverPublicKey) {
+		t.Error("keys did not survive the round trip")
+	}
+	if !c.sessionExpiry.Equal(orig.sessionExpiry.Round(time.Microsecond)) {
+		t.Errorf("session expiry = %v, want %v", c.sessionExpiry, orig.sessionExpiry)
+	}
+	if c.cfg.APIKey != "sandbox_key" || c.UserID() != 1 {
+		t.Errorf("got API key %q user %d", c.cfg.APIKey, c.UserID())
+	}
+	// Python parses the expiry with %f, which needs the microseconds even
+	// when they are zero.
+	orig.sessionExpiry = time.Date(2030, 1, 2, 3, 4, 5, 0, time.Local)
+	if data, err = orig.ExportPythonContext(); err != nil {
+		t.Fatal(err)
+	}
+	var pc pythonContext
+	if err := json.Unmarshal(data, &pc); err != nil {
+		t.Fatal(err)
+	}
+	if got := pc.SessionContext.ExpiryTime; got != "2030-01-02 03:04:05.000000" {
+		t.Errorf("expiry_time = %q, want fixed-width microseconds", got)
+	}
+}
+
+func TestExportPythonContext_NoInstallation(t *testing.T) {
+	c := &Client{}
+	if _, err := c.ExportPythonContext(); err == nil {
+		t.Error("expected error for client without installation")
+	}
+}
+
+func TestListStopBefore(t *testing.T) {
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.URL.Query().Get("older_id") == "" {
+			fmt.Fprint(w, `{"Response":[`+
+				`{"Payment":{"id":4,"created":"2024-03-04 09:00:00.000000"}},`+
+				`{"Payment":{"id":3,"created":"2024-03-02 09:00:00.000000"}}],`+
+				`"Pagination":{"older_url":"/v1/user/1/monetary-account/2/payment?older_id=3&count=2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"Response":[`+
+			`{"Payment":{"id":2,"created":"2024-03-01 12:00:00.000000"}},`+
+			`{"Payment":{"id":1,"created":"2024-02-28 09:00:00.000000"}}],`+
+			`"Pagination":{"older_url":"/v1/user/1/monetary-account/2/payment?older_id=1&count=2"}}`)
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+
+	cutoff := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	var ids []int
+	for p, err := range c.Payment.List(context.Background(), 0, &ListOptions{Count: 2, StopBefore: cutoff}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids = append(ids, p.ID)
+	}
+	if fmt.Sprint(ids) != "[4 3 2]" {
+		t.Errorf("expected [4 3 2], got %v", ids)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", requests.Load())
+	}
+}
+
+// TestClientConcurrentUse exercises a shared Client from many goroutines while
+// the session is being refreshed. Run with -race.
+func TestClientConcurrentUse(t *testing.T) {
+	var refreshes atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/session-server":
+			n := refreshes.Add(1)
+			// A session_timeout below the refresh margin makes every
+			// request refresh again.
+			fmt.Fprintf(w, `{"Response":[{"Id":{"id":1}},{"Token":{"token":"session-%d"}},{"UserPerson":{"id":1,"session_timeout":1}}]}`, n)
+		case r.URL.Path == "/user/1/monetary-account/2/payment":
+			fmt.Fprint(w, `{"Response":[{"Payment":{"id":1}},{"Payment":{"id":2}}]}`)
+		case r.URL.Path == "/user/1/monetary-account/2/payment/1":
+			fmt.Fprint(w, `{"Response":[{"Payment":{"id":1}}]}`)
+		case r.URL.Path == "/user/1/card/3":
+			fmt.Fprint(w, `{"Response":[{"Card":{"id":3}}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	key, err := generateRSAKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newMockClient(srv)
+	c.cfg.APIKey = "key"
+	c.privateKey, c.serverPublicKey, c.installationToken = key, &key.PublicKey, "installation"
+	c.sessionExpiry = time.Now()
+
+	ctx := context.Background()
+	opts := &ListOptions{} // shared on purpose
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var err error
+			switch i % 4 {
+			case 0:
+				_, err = c.Payment.Get(ctx, 0, 1)
+			case 1:
+				for _, err = range c.Payment.List(ctx, 0, opts) {
+					if err != nil {
+						break
+					}
+				}
+			case 2:
+				_, err = c.Card.Get(ctx, 3)
+			case 3:
+				_, err = c.ExportPythonContext()
+				if c.UserID() != 1 {
+					err = fmt.Errorf("user ID changed to %d", c.UserID())
+				}
+			}
+			if err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if refreshes.Load() == 0 {
+		t.Error("expected at least one session refresh")
+	}
+	if opts.Count != 0 {
+		t.Errorf("List modified the caller's ListOptions: %+v", opts)
+	}
+}
+
+func TestMaxResponseBytes(t *testing.T) {
+	large := `{"Response":[{"Payment":{"id":1,"description":"` + strings.Repeat("x", 1024) + `"}}]}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, large)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	c.cfg.MaxResponseBytes = 512
+
+	_, err := c.Payment.Get(context.Background(), 0, 1)
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("expected ErrResponseTooLarge, got %v", err)
+	}
+
+	// Binary downloads are not limited.
+	body, _, err := c.download(context.Background(), "user/1/attachment/1/content")
+	if err != nil {
+		t.Fatalf("download: unexpected error: %v", err)
+	}
+	if len(body) != len(large) {
+		t.Errorf("download: expected %d bytes, got %d", len(large), len(body))
+	}
+
+	// A body exactly at the limit is accepted.
+	c.cfg.MaxResponseBytes = int64(len(large))
+	if _, err := c.Payment.Get(context.Background(), 0, 1); err != nil {
+		t.Errorf("unexpected error at limit: %v", err)
+	}
+}
+
+func TestServerPublicKey(t *testing.T) {
+	path := writePythonContext(t, time.Now().Add(time.Hour))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"Response":[{"MonetaryAccountBank":{"id":7,"status":"ACTIVE"}}]}`)
+	}))
+	defer srv.Close()
+
+	c, err := NewClientFromPythonContext(context.Background(), path, Config{Environment: Environment{BaseURL: srv.URL}, HTTPClient: srv.Client()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pub := c.ServerPublicKey()
+	if pub == nil || !pub.Equal(c.serverPublicKey) {
+		t.Fatalf("expected bootstrapped server key, got %v", pub)
+	}
+
+	// The key verifies bodies signed by the server.
+	serverKey, err := generateRSAKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.serverPublicKey = &serverKey.PublicKey
+	body := []byte(`{"NotificationUrl":{}}`)
+	sig, err := signRequest(serverKey, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyResponse(c.ServerPublicKey(), body, sig); err != nil {
+		t.Errorf("verifying callback signature: %v", err)
+	}
+}
+
+func TestNewClient_Bootstrap(t *testing.T) {
+	serverKey, err := generateRSAKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverPEM, _ := json.Marshal(publicKeyToPEM(&serverKey.PublicKey))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/installation":
+			fmt.Fprintf(w, `{"Response":[{"Id":{"id":11}},{"Token":{"token":"installation"}},{"ServerPublicKey":{"server_public_key":%s}}]}`, serverPEM)
+		case "/device-server":
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":22}}]}`)
+		case "/session-server":
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":33}},{"Token":{"token":"session"}},{"UserCompany":{"id":44,"session_timeout":3600}}]}`)
+		case "/user/44/monetary-account":
+			fmt.Fprint(w, `{"Response":[{"MonetaryAccountBank":{"id":54,"status":"CANCELLED"}},{"MonetaryAccountBank":{"id":55,"status":"ACTIVE"}}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(context.Background(), Config{
+		APIKey:      "key",
+		Environment: Environment{BaseURL: srv.URL},
+		HTTPClient:  srv.Client(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	b := c.Bootstrap()
+	if b.InstallationID != 11 || b.DeviceID != 22 || b.SessionID != 33 {
+		t.Errorf("got installation %d device %d session %d, want 11 22 33", b.InstallationID, b.DeviceID, b.SessionID)
+	}
+	if b.UserID != 44 || b.UserType != "UserCompany" {
+		t.Errorf("got user %d (%s), want 44 (UserCompany)", b.UserID, b.UserType)
+	}
+	if b.PrimaryMonetaryAccountID != 55 {
+		t.Errorf("expected primary account 55, got %d", b.PrimaryMonetaryAccountID)
+	}
+	if b.InstallationTime <= 0 || b.DeviceTime <= 0 || b.SessionTime <= 0 || b.AccountTime <= 0 {
+		t.Errorf("expected all step timings to be set: %+v", b)
+	}
+}
+
+func TestNewClient_BootstrapObserver(t *testing.T) {
+	serverKey, err := testKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverPEM, _ := json.Marshal(publicKeyToPEM(&serverKey.PublicKey))
+
+	failSession := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/installation":
+			fmt.Fprintf(w, `{"Response":[{"Id":{"id":11}},{"Token":{"token":"installation"}},{"ServerPublicKey":{"server_public_key":%s}}]}`, serverPEM)
+		case "/device-server":
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":22}}]}`)
+		case "/session-server":
+			if failSession {
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, `{"Error":[{"error_description":"Invalid API key"}]}`)
+				return
+			}
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":33}},{"Token":{"token":"session"}},{"UserPerson":{"id":44,"session_timeout":3600}}]}`)
+		case "/user/44/monetary-account":
+			fmt.Fprint(w, `{"Response":[{"MonetaryAccountBank":{"id":55,"status":"ACTIVE"}}]}`)
+		}
+	}))
+	defer srv.Close()
+
+	var events []BootstrapEvent
+	cfg := Config{
+		APIKey:            "key",
+		Environment:       Environment{BaseURL: srv.URL},
+		HTTPClient:        srv.Client(),
+		BootstrapObserver: func(e BootstrapEvent) { events = append(events, e) },
+	}
+	c, err := NewClient(context.Background(), cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{BootstrapStepInstallation, BootstrapStepDeviceServer, BootstrapStepSessionServer, BootstrapStepPrimaryAccount}
+	var steps []string
+	for _, e := range events {
+		steps = append(steps, e.Step)
+		if e.Err != nil || e.Duration <= 0 {
+			t.Errorf("unexpected event %+v", e)
+		}
+	}
+	if !slices.Equal(steps, want) {
+		t.Errorf("expected steps %q, got %q", want, steps)
+	}
+	if events[0].Duration != c.Bootstrap().InstallationTime {
+		t.Errorf("event duration %v differs from BootstrapResult %v", events[0].Duration, c.Bootstrap().InstallationTime)
+	}
+
+	// A failing step is reported, and no later steps run.
+	events = nil
+	failSession = true
+	if _, err := NewClient(context.Background(), cfg); err == nil {
+		t.Fatal("expected error")
+	}
+	last := events[len(events)-1]
+	var authErr *UnauthorizedError
+	if len(events) != 3 || last.Step != BootstrapStepSessionServer || !errors.As(last.Err, &authErr) {
+		t.Errorf("expected failed session-server step last, got %+v", events)
+	}
+}
+
+func TestWithRetryBudget(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := calls.Add(1)
+		// Every other request is rate limited, so each page needs one retry.
+		if n%2 == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"Error":[{"error_description":"Too many requests"}]}`)
+			return
+		}
+		page := n / 2
+		fmt.Fprintf(w, `{"Response":[{"Payment":{"id":%d}}],"Pagination":{"older_url":"/v1/user/1/monetary-account/2/payment?older_id=%d"}}`, 100-page, 100-page)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	c.cfg.RetryPolicy = func(attempt, status int, err error) (bool, time.Duration) {
+		return status == http.StatusTooManyRequests && attempt < 5, time.Millisecond
+	}
+
+	ctx := WithRetryBudget(context.Background(), 3)
+	var ids []int
+	var iterErr error
+	for p, err := range c.Payment.List(ctx, 0, nil) {
+		if err != nil {
+			iterErr = err
+			break
+		}
+		ids = append(ids, p.ID)
+	}
+
+	if !errors.Is(iterErr, ErrRetryBudgetExhausted) {
+		t.Fatalf("expected ErrRetryBudgetExhausted, got %v", iterErr)
+	}
+	var tooMany *TooManyRequestsError
+	if !errors.As(iterErr, &tooMany) {
+		t.Errorf("expected the last 429 to be wrapped, got %v", iterErr)
+	}
+	if len(ids) != 3 {
+		t.Errorf("expected 3 pages before the budget ran out, got %v", ids)
+	}
+	if n := calls.Load(); n != 7 {
+		t.Errorf("expected 7 requests, got %d", n)
+	}
+}
+
+func TestMonetaryAccountProfile(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/1/monetary-account-bank/2" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodPut:
+			var got map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			want := map[string]any{"monetary_account_profile": map[string]any{
+				"profile_drain": map[string]any{
+					"status":                 "ACTIVE",
+					"balance_preferred":      map[string]any{"value": "500.00", "currency": "EUR"},
+					"balance_threshold_high": map[string]any{"value": "1000.00", "currency": "EUR"},
+					"savings_account_alias":  map[string]any{"type": "IBAN", "value": "NL00BUNQ0000000002", "name": "Savings"},
+				},
+			}}
+			if fmt.Sprint(got) != fmt.Sprint(want) {
+				t.Errorf("request body:\n got %v\nwant %v", got, want)
+			}
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":2}}]}`)
+		case http.MethodGet:
+			fmt.Fprint(w, `{"Response":[{"MonetaryAccountBank":{"id":2,"monetary_account_profile":{`+
+				`"profile_fill":{"status":"ACTIVE","balance_preferred":{"value":"100.00","currency":"EUR"},"balance_threshold_low":{"value":"20.00","currency":"EUR"},"issuer":{"bic":"BUNQNL2A","name":"bunq"}},`+
+				`"profile_drain":{"status":"ACTIVE","balance_threshold_high":{"value":"1000.00","currency":"EUR"},"savings_account_alias":{"iban":"NL00BUNQ0000000002","display_name":"Savings"}}}}}]}`)
+		}
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+
+	id, err := c.MonetaryAccountProfile.Update(context.Background(), 0, MonetaryAccountProfileParams{
+		ProfileDrain: &MonetaryAccountProfileDrainParams{
+			Status:               "ACTIVE",
+			BalancePreferred:     NewAmount(500, "EUR"),
+			BalanceThresholdHigh: NewAmount(1000, "EUR"),
+			SavingsAccountAlias:  &Pointer{Type: "IBAN", Value: "NL00BUNQ0000000002", Name: "Savings"},
+		},
+	})
+	if err != nil || id != 2 {
+		t.Fatalf("update: got %d, %v", id, err)
+	}
+
+	profile, err := c.MonetaryAccountProfile.Get(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if profile.ProfileFill == nil || profile.ProfileFill.BalanceThresholdLow.Value != "20.00" || profile.ProfileFill.Issuer.Bic != "BUNQNL2A" {
+		t.Errorf("unexpected profile_fill: %+v", profile.ProfileFill)
+	}
+	if profile.ProfileDrain == nil || profile.ProfileDrain.SavingsAccountAlias.IBAN != "NL00BUNQ0000000002" {
+		t.Errorf("unexpected profile_drain: %+v", profile.ProfileDrain)
+	}
+}
+
+func TestPaymentCounterpartyAlias(t *testing.T) {
+	body := `{"Response":[{"Payment":{"id":1,` +
+		`"alias":{"iban":"NL00BUNQ0000000001","display_name":"Alice","avatar":{"uuid":"av-1"},"label_user":{"uuid":"u-1","display_name":"Alice","country":"NL"},"country":"NL"},` +
+		`"counterparty_alias":{"iban":"NL00BUNQ0000000002","display_name":"Bob's Bikes","label_user":{"display_name":"Bob"},"country":"NL","merchant_category_code":"5940"}}}]}`
+	p, err := unmarshalObject[Payment]([]byte(body), "Payment")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.Alias == nil || p.Alias.IBAN != "NL00BUNQ0000000001" || p.Alias.DisplayName != "Alice" {
+		t.Errorf("unexpected alias: %+v", p.Alias)
+	}
+	if p.Alias.Avatar == nil || p.Alias.Avatar.UUID != "av-1" {
+		t.Errorf("unexpected alias avatar: %+v", p.Alias.Avatar)
+	}
+	cp := p.CounterpartyAlias
+	if cp == nil || cp.IBAN != "NL00BUNQ0000000002" || cp.DisplayName != "Bob's Bikes" {
+		t.Fatalf("unexpected counterparty alias: %+v", cp)
+	}
+	if cp.LabelUser == nil || cp.LabelUser.DisplayName != "Bob" {
+		t.Errorf("unexpected counterparty label_user: %+v", cp.LabelUser)
+	}
+}
+
+func TestListOptions_Filters(t *testing.T) {
+	var queries []url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		if len(queries) == 1 {
+			fmt.Fprint(w, `{"Response":[{"Payment":{"id":2}}],"Pagination":{"older_url":"/v1/user/1/monetary-account/2/payment?older_id=2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"Response":[{"Payment":{"id":1}}]}`)
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+
+	opts := &ListOptions{Count: 1, Filters: map[string]string{"status": "ACTIVE"}}
+	for _, err := range c.Payment.List(context.Background(), 0, opts) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(queries) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(queries))
+	}
+	for i, q := range queries {
+		if q.Get("status") != "ACTIVE" || q.Get("count") != "1" {
+			t.Errorf("request %d: unexpected query %v", i, q)
+		}
+	}
+	if queries[1].Get("older_id") != "2" {
+		t.Errorf("expected older_id=2 on second page, got %v", queries[1])
+	}
+
+	opts = &ListOptions{Filters: map[string]string{"older_id": "5"}}
+	for _, err := range c.Payment.List(context.Background(), 0, opts) {
+		if err == nil {
+			t.Fatal("expected error for reserved filter key")
+		}
+	}
+	if len(queries) != 2 {
+		t.Errorf("expected no request for invalid options, got %d", len(queries)-2)
+	}
+}
+
+func TestListOptions_Flags(t *testing.T) {
+	var queries []url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		if len(queries) == 1 {
+			fmt.Fprint(w, `{"Response":[{"Event":{"id":2}}],"Pagination":{"older_url":"/v1/user/1/event?older_id=2"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"Response":[{"Event":{"id":1}}]}`)
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+
+	opts := &ListOptions{Flags: map[string]bool{ListFlagDisplayUserEvent: false}}
+	for _, err := range c.Event.List(context.Background(), opts) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(queries) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(queries))
+	}
+	for i, q := range queries {
+		if q.Get("display_user_event") != "false" {
+			t.Errorf("request %d: unexpected query %v", i, q)
+		}
+	}
+
+	opts.Flags[ListFlagDisplayUserEvent] = true
+	for _, err := range c.Event.List(context.Background(), opts) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		break
+	}
+	if got := queries[2].Get("display_user_event"); got != "true" {
+		t.Errorf("display_user_event = %q, want true", got)
+	}
+
+	for _, err := range c.Payment.List(context.Background(), 0, opts) {
+		if err == nil {
+			t.Fatal("expected error for a flag payments do not support")
+		}
+	}
+	opts.Filters = map[string]string{ListFlagDisplayUserEvent: "true"}
+	for _, err := range c.Event.List(context.Background(), opts) {
+		if err == nil {
+			t.Fatal("expected error for a flag that is also a filter")
+		}
+	}
+	if len(queries) != 3 {
+		t.Errorf("expected no request for invalid options, got %d", len(queries)-3)
+	}
+}
+
+func TestNewIBANPointer(t *testing.T) {
+	p, err := NewIBANPointer("nl91 abna 0417 1643 00", " J. Doe ")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.Type != "IBAN" || p.Value != "NL91ABNA0417164300" || p.Name != "J. Doe" {
+		t.Errorf("unexpected pointer: %+v", p)
+	}
+
+	if _, err := NewIBANPointer("NL91ABNA0417164300", ""); err == nil {
+		t.Error("expected error for missing name")
+	}
+	for _, iban := range []string{"NL92ABNA0417164300", "NL91ABNA04171643", "9191ABNA0417164300", "NL91ABNA04171643-0"} {
+		if _, err := NewIBANPointer(iban, "J. Doe"); err == nil {
+			t.Errorf("expected error for invalid IBAN %q", iban)
+		}
+	}
+
+	// A hand-built pointer is checked like NewIBANPointer's input.
+	if err := (&Pointer{Type: "IBAN", Value: "nl91 abna 0417 1643 00", Name: "J. Doe"}).Validate(); err != nil {
+		t.Errorf("unexpected error for an IBAN with spaces: %v", err)
+	}
+
+	// Other pointer types are not checked.
+	if err := (&Pointer{Type: "EMAIL", Value: "bravo@bunq.com"}).Validate(); err != nil {
+		t.Errorf("unexpected error for email pointer: %v", err)
+	}
+}
+
+func TestSendPayment_InvalidCounterparty(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+
+	_, err := c.SendPayment(context.Background(), 0, PaymentCreateParams{
+		Amount:            NewAmount(1, "EUR"),
+		CounterpartyAlias: &Pointer{Type: "IBAN", Value: "NL91ABNA0417164300"},
+		Description:       "no name",
+	})
+	if err == nil {
+		t.Fatal("expected error for IBAN pointer without name")
+	}
+
+	if _, err := c.Payment.Create(context.Background(), 0, PaymentCreateParams{
+		Amount:            NewAmount(1, "EUR"),
+		CounterpartyAlias: &Pointer{Type: "IBAN", Value: "NL92ABNA0417164300", Name: "J. Doe"},
+		Description:       "bad checksum",
+	}); err == nil {
+		t.Fatal("expected Payment.Create to reject an invalid IBAN")
+	}
+}
+
+func TestExportAnnualOverview(t *testing.T) {
+	pdf := []byte("%PDF-1.4 annual overview")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/user/1/export-annual-overview":
+			var got map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+				t.Fatal(err)
+			}
+			if got["year"] != float64(2025) || len(got) != 1 {
+				t.Errorf("unexpected create body: %v", got)
+			}
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":8}}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/user/1/export-annual-overview/8/content":
+			w.Header().Set("Content-Type", "application/pdf")
+			w.Write(pdf)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+
+	id, err := c.ExportAnnualOverview.Create(context.Background(), ExportAnnualOverviewCreateParams{Year: 2025})
+	if err != nil || id != 8 {
+		t.Fatalf("create: got %d, %v", id, err)
+	}
+	data, contentType, err := c.ExportAnnualOverview.Download(context.Background(), id)
+	if err != nil {
+		t.Fatalf("download: %v", err)
+	}
+	if string(data) != string(pdf) || contentType != "application/pdf" {
+		t.Errorf("got %q (%s)", data, contentType)
+	}
+}
+
+func TestObserver_RequestTag(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"Response":[{"Payment":{"id":1}}]}`)
+	}))
+	defer srv.Close()
+
+	var events []RequestEvent
+	c := newMockClient(srv)
+	c.cfg.RetryPolicy = func(attempt, status int, err error) (bool, time.Duration) {
+		return status == http.StatusTooManyRequests, time.Millisecond
+	}
+	c.cfg.Observer = func(e RequestEvent) { events = append(events, e) }
+
+	ctx := WithRequestTag(context.Background(), "reconcile")
+	if _, err := c.Payment.Get(ctx, 0, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.Payment.Get(context.Background(), 0, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(events) != 3 {
+		t.Fatalf("expected 3 events, got %d: %+v", len(events), events)
+	}
+	want := []struct {
+		attempt, status int
+		tag             string
+	}{
+		{0, http.StatusTooManyRequests, "reconcile"},
+		{1, http.StatusOK, "reconcile"},
+		{0, http.StatusOK, ""},
+	}
+	for i, w := range want {
+		e := events[i]
+		if e.Attempt != w.attempt || e.StatusCode != w.status || e.Tag != w.tag {
+			t.Errorf("event %d: got attempt %d status %d tag %q, want %d %d %q", i, e.Attempt, e.StatusCode, e.Tag, w.attempt, w.status, w.tag)
+		}
+		if e.Method != http.MethodGet || e.Path != "user/1/monetary-account/2/payment/1" {
+			t.Errorf("event %d: got %s %s", i, e.Method, e.Path)
+		}
+	}
+}
+
+func TestUpdate_ForceSendFields(t *testing.T) {
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		fmt.Fprint(w, `{"Response":[{"Id":{"id":2}}]}`)
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+	ctx := context.Background()
+
+	// Only the changed field is sent.
+	if _, err := c.MonetaryAccountBank.Update(ctx, 2, MonetaryAccountBankUpdateParams{DisplayName: "Groceries"}); err != nil {
+		t.Fatal(err)
+	}
+	// An empty string is dropped by omitempty unless forced.
+	if _, err := c.MonetaryAccountBank.Update(ctx, 2, MonetaryAccountBankUpdateParams{
+		DisplayName:     "Groceries",
+		ForceSendFields: []string{"Description", "DailyLimit"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`{"display_name":"Groceries"}`,
+		`{"daily_limit":null,"description":"","display_name":"Groceries"}`,
+	}
+	for i := range want {
+		if bodies[i] != want[i] {
+			t.Errorf("body %d:\n got %s\nwant %s", i, bodies[i], want[i])
+		}
+	}
+
+	_, err := c.MonetaryAccountBank.Update(ctx, 2, MonetaryAccountBankUpdateParams{ForceSendFields: []string{"Nope"}})
+	if err == nil {
+		t.Error("expected error for unknown ForceSendFields entry")
+	}
+	if len(bodies) != 2 {
+		t.Errorf("expected no request for invalid params, got %d", len(bodies))
+	}
+}
+
+func TestPayIBAN(t *testing.T) {
+	var body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/user/1/monetary-account/2/payment" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		fmt.Fprint(w, `{"Response":[{"Id":{"id":77}}]}`)
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+
+	id, err := c.PayIBAN(context.Background(), 0, "NL91 ABNA 0417 1643 00", "J. Doe", NewAmount(12.5, "EUR"), "invoice 42")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != 77 {
+		t.Errorf("expected ID 77, got %d", id)
+	}
+	want := `{"amount":{"value":"12.50","currency":"EUR"},"counterparty_alias":{"type":"IBAN","value":"NL91ABNA0417164300","name":"J. Doe"},"description":"invoice 42"}`
+	if body != want {
+		t.Errorf("request body:\n got %s\nwant %s", body, want)
+	}
+
+	if _, err := c.PayIBAN(context.Background(), 0, "NL00ABNA0417164300", "J. Doe", NewAmount(1, "EUR"), "x"); err == nil {
+		t.Error("expected error for invalid IBAN")
+	}
+}
+
+func TestSuggestedDelay(t *testing.T) {
+	var l requestLog
+	now := time.Now()
+	if d := l.suggestedDelay(now); d != 0 {
+		t.Errorf("expected no delay without requests, got %v", d)
+	}
+
+	// A burst of three requests fills the 3-per-3s window.
+	for i := range 3 {
+		l.record(now.Add(time.Duration(i)*100*time.Millisecond), http.StatusOK)
+	}
+	if d := l.suggestedDelay(now.Add(300 * time.Millisecond)); d != 2700*time.Millisecond {
+		t.Errorf("expected 2.7s after a burst, got %v", d)
+	}
+	if d := l.suggestedDelay(now.Add(4 * time.Second)); d != 0 {
+		t.Errorf("expected no delay once the window has passed, got %v", d)
+	}
+
+	// A 429 means waiting out bunq's cooldown.
+	l.record(now.Add(5*time.Second), http.StatusTooManyRequests)
+	if d := l.suggestedDelay(now.Add(10 * time.Second)); d != 25*time.Second {
+		t.Errorf("expected 25s after a 429, got %v", d)
+	}
+}
+
+func TestClient_SuggestedDelay(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"Response":[{"Payment":{"id":1}}]}`)
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+
+	for range 3 {
+		if _, err := c.Payment.Get(context.Background(), 0, 1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if d := c.SuggestedDelay(); d <= 0 || d > rateLimitWindow {
+		t.Errorf("expected a delay within the rate limit window, got %v", d)
+	}
+}
+
+func TestMonetaryAccountBank_ListByStatus(t *testing.T) {
+	var query url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/1/monetary-account-bank" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		query = r.URL.Query()
+		fmt.Fprint(w, `{"Response":[`+
+			`{"MonetaryAccountBank":{"id":3,"status":"CANCELLED"}},`+
+			`{"MonetaryAccountBank":{"id":2,"status":"ACTIVE"}},`+
+			`{"MonetaryAccountBank":{"id":1,"status":"CANCELLED"}}]}`)
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+
+	opts := &ListOptions{Filters: map[string]string{"foo": "bar"}}
+	var ids []int
+	for a, err := range c.MonetaryAccountBank.ListByStatus(context.Background(), MonetaryAccountCancelled, opts) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids = append(ids, a.ID)
+	}
+	if query.Get("status") != "CANCELLED" || query.Get("foo") != "bar" {
+		t.Errorf("unexpected query %v", query)
+	}
+	if fmt.Sprint(ids) != "[3 1]" {
+		t.Errorf("expected [3 1], got %v", ids)
+	}
+	if _, ok := opts.Filters["status"]; ok {
+		t.Error("ListByStatus modified the caller's filters")
+	}
+}
+
+func TestPermittedIp(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(b))
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"Response":[{"PermittedIp":{"ip":"192.0.2.1","status":"ACTIVE"}},{"PermittedIp":{"ip":"192.0.2.7","status":"INACTIVE"}}]}`)
+		default:
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":5}}]}`)
+		}
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+	ctx := context.Background()
+
+	id, err := c.PermittedIp.Add(ctx, 9, "192.0.2.1")
+	if err != nil || id != 5 {
+		t.Fatalf("add: got %d, %v", id, err)
+	}
+	if err := c.PermittedIp.Remove(ctx, 9, id); err != nil {
+		t.Fatalf("remove: %v", err)
+	}
+	var ips []PermittedIp
+	for ip, err := range c.PermittedIp.List(ctx, 9, nil) {
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		ips = append(ips, ip)
+	}
+
+	want := []string{
+		`POST /user/1/credential-password-ip/9/ip {"ip":"192.0.2.1","status":"ACTIVE"}`,
+		`PUT /user/1/credential-password-ip/9/ip/5 {"status":"INACTIVE"}`,
+		`GET /user/1/credential-password-ip/9/ip `,
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Errorf("request %d:\n got %s\nwant %s", i, requests[i], want[i])
+		}
+	}
+	if len(ips) != 2 || ips[0].IP != "192.0.2.1" || ips[1].Status != "INACTIVE" {
+		t.Errorf("unexpected permitted IPs: %+v", ips)
+	}
+}
+
+func TestNewClient_InstallationError(t *testing.T) {
+	serverKey, err := generateRSAKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	goodPEM, _ := json.Marshal(publicKeyToPEM(&serverKey.PublicKey))
+	badPEM, _ := json.Marshal("-----BEGIN PUBLIC KEY-----\nbm90IGEga2V5\n-----END PUBLIC KEY-----\n")
+
+	tests := []struct {
+		name string
+		body string
+		want InstallationFailure
+	}{
+		{"no token", fmt.Sprintf(`{"Response":[{"Id":{"id":1}},{"ServerPublicKey":{"server_public_key":%s}}]}`, goodPEM), InstallationNoToken},
+		{"no server key", `{"Response":[{"Id":{"id":1}},{"Token":{"token":"t"}}]}`, InstallationNoServerKey},
+		{"bad server key", fmt.Sprintf(`{"Response":[{"Id":{"id":1}},{"Token":{"token":"t"}},{"ServerPublicKey":{"server_public_key":%s}}]}`, badPEM), InstallationBadServerKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/installation" {
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+				fmt.Fprint(w, tt.body)
+			}))
+			defer srv.Close()
+
+			_, err := NewClient(context.Background(), Config{APIKey: "key", Environment: Environment{BaseURL: srv.URL}, HTTPClient: srv.Client()})
+			var instErr *InstallationError
+			if !errors.As(err, &instErr) {
+				t.Fatalf("expected InstallationError, got %v", err)
+			}
+			if instErr.Reason != tt.want {
+				t.Errorf("expected reason %d, got %d (%v)", tt.want, instErr.Reason, err)
+			}
+			if tt.want == InstallationBadServerKey && instErr.Unwrap() == nil {
+				t.Error("expected the PEM parse error to be wrapped")
+			}
+		})
+	}
+}
+
+func TestListAcrossAccounts(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		var account int
+		fmt.Sscanf(r.URL.Path, "/user/1/monetary-account/%d/payment", &account)
+		if account == 4 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("older_id") == "" {
+			fmt.Fprintf(w, `{"Response":[{"Payment":{"id":%d2}}],"Pagination":{"older_url":"/v1/x?older_id=%d2"}}`, account, account)
+			return
+		}
+		fmt.Fprintf(w, `{"Response":[{"Payment":{"id":%d1}}]}`, account)
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+
+	list := func(ctx context.Context, id int) iter.Seq2[Payment, error] {
+		return c.Payment.List(ctx, id, nil)
+	}
+	got := map[int][]int{}
+	var failed []int
+	for r, err := range ListAcrossAccounts(context.Background(), []int{2, 3, 4}, 2, list) {
+		if err != nil {
+			failed = append(failed, r.MonetaryAccountID)
+			continue
+		}
+		got[r.MonetaryAccountID] = append(got[r.MonetaryAccountID], r.Item.ID)
+	}
+
+	if fmt.Sprint(got) != "map[2:[22 21] 3:[32 31]]" {
+		t.Errorf("unexpected items per account: %v", got)
+	}
+	if fmt.Sprint(failed) != "[4]" {
+		t.Errorf("expected account 4 to fail, got %v", failed)
+	}
+	if m := maxInFlight.Load(); m > 2 {
+		t.Errorf("expected at most 2 concurrent requests, got %d", m)
+	}
+
+	// Stopping early must not leave goroutines blocked.
+	for range ListAcrossAccounts(context.Background(), []int{2, 3}, 2, list) {
+		break
+	}
+}
+
+func TestIsSessionExpired(t *testing.T) {
+	tests := []struct {
+		status int
+		body   string
+		want   bool
+	}{
+		{401, `{"Error":[{"error_description":"Insufficient authentication.","error_description_translated":"Insufficient authentication."}]}`, true},
+		{401, `{"Error":[{"error_description":"Authentication token is invalid."}]}`, true},
+		{401, `{"Error":[{"error_description":"User credentials are incorrect. Incorrect API key or IP address."}]}`, false},
+		{403, `{"Error":[{"error_description":"Insufficient authentication."}]}`, false},
+	}
+	for _, tt := range tests {
+		err := fmt.Errorf("getting payment: %w", newAPIError(tt.status, "r", []byte(tt.body)))
+		if got := IsSessionExpired(err); got != tt.want {
+			t.Errorf("IsSessionExpired(%d %s) = %v, want %v", tt.status, tt.body, got, tt.want)
+		}
+	}
+	if IsSessionExpired(errors.New("executing request: timeout")) {
+		t.Error("expected false for non-API errors")
+	}
+}
+
+func TestBunqMeFundraiser(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/user/1/monetary-account/2/bunqme-fundraiser-profile":
+			b, _ := io.ReadAll(r.Body)
+			want := `{"pointer":{"type":"URL","value":"https://bunq.me/acme"},"description":"Roof repair","color":"#FF6600"}`
+			if string(b) != want {
+				t.Errorf("create body:\n got %s\nwant %s", b, want)
+			}
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":3}}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/user/1/monetary-account/2/bunqme-fundraiser-result":
+			fmt.Fprint(w, `{"Response":[{"BunqMeFundraiserResult":{"id":9,"bunqme_fundraiser_profile":{"description":"Roof repair","status":"ACTIVE"},`+
+				`"payments":[{"id":11,"amount":{"value":"25.00","currency":"EUR"}},{"id":12,"amount":{"value":"7.50","currency":"EUR"}}]}}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+
+	id, err := c.BunqMeFundraiser.CreateProfile(context.Background(), 0, BunqMeFundraiserProfileCreateParams{
+		Pointer:     &Pointer{Type: "URL", Value: "https://bunq.me/acme"},
+		Description: "Roof repair",
+		Color:       "#FF6600",
+	})
+	if err != nil || id != 3 {
+		t.Fatalf("create: got %d, %v", id, err)
+	}
+
+	var results []BunqMeFundraiserResult
+	for r, err := range c.BunqMeFundraiser.ListResults(context.Background(), 0, nil) {
+		if err != nil {
+			t.Fatalf("list: %v", err)
+		}
+		results = append(results, r)
+	}
+	if len(results) != 1 || results[0].BunqmeFundraiserProfile.Description != "Roof repair" {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+	if p := results[0].Payments; len(p) != 2 || p[0].Amount.Value != "25.00" || p[1].Amount.Value != "7.50" {
+		t.Errorf("unexpected payments: %+v", p)
+	}
+}
+
+func TestAPIError_UserMessage(t *testing.T) {
+	body := `{"Error":[{"error_description":"Payment amount exceeds daily limit [limit_id: 42].","error_description_translated":"Dit bedrag is hoger dan je daglimiet."},{"error_description":"Second issue."}]}`
+	err := newAPIError(400, "resp-9", []byte(body))
+
+	var apiErr *BadRequestError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected BadRequestError, got %T", err)
+	}
+	if got := apiErr.UserMessage(); got != "Dit bedrag is hoger dan je daglimiet." {
+		t.Errorf("UserMessage() = %q", got)
+	}
+	if full := err.Error(); !strings.Contains(full, "resp-9") || !strings.Contains(full, "Second issue.") {
+		t.Errorf("Error() should keep status, response ID and all messages, got %q", full)
+	}
+
+	// Without a translation the plain description is used.
+	err = newAPIError(404, "", []byte(`{"Error":[{"error_description":"Not found."}]}`))
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) || notFound.UserMessage() != "Not found." {
+		t.Errorf("unexpected UserMessage for %v", err)
+	}
+}
+
+func TestListUnseen(t *testing.T) {
+	var events string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"Response":[%s],"Pagination":{}}`, events)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "cursor.json")
+
+	seen := func() []int {
+		store := &FileCursorStore{Path: path} // fresh store, as in a new run
+		var ids []int
+		for e, err := range ListUnseen(ctx, store, "events", c.Event.List) {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			ids = append(ids, e.ID)
+		}
+		return ids
+	}
+
+	events = `{"Event":{"id":3}},{"Event":{"id":2}},{"Event":{"id":1}}`
+	if got := seen(); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("first run: expected [3 2 1], got %v", got)
+	}
+
+	events = `{"Event":{"id":5}},{"Event":{"id":4}},{"Event":{"id":3}},{"Event":{"id":2}}`
+	if got := seen(); !slices.Equal(got, []int{5, 4}) {
+		t.Errorf("second run: expected [5 4], got %v", got)
+	}
+	if got := seen(); len(got) != 0 {
+		t.Errorf("third run: expected nothing, got %v", got)
+	}
+
+	mark, err := (&FileCursorStore{Path: path}).Load(ctx, "events")
+	if err != nil || mark != 5 {
+		t.Errorf("expected stored mark 5, got %d (%v)", mark, err)
+	}
+}
+
+func TestListUnseen_EarlyStopKeepsMark(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"Response":[{"Event":{"id":2}},{"Event":{"id":1}}],"Pagination":{}}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+	store := &FileCursorStore{Path: filepath.Join(t.TempDir(), "cursor.json")}
+
+	for range ListUnseen(ctx, store, "events", c.Event.List) {
+		break
+	}
+	if mark, _ := store.Load(ctx, "events"); mark != 0 {
+		t.Errorf("expected mark to stay 0 after early stop, got %d", mark)
+	}
+}
+
+func TestListResumable(t *testing.T) {
+	// Six events, newest first, two per page.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		top := 6
+		if s := r.URL.Query().Get("older_id"); s != "" {
+			top, _ = strconv.Atoi(s)
+			top--
+		}
+		var items []string
+		for id := top; id > max(top-2, 0); id-- {
+			items = append(items, fmt.Sprintf(`{"Event":{"id":%d}}`, id))
+		}
+		pagination := "{}"
+		if top > 2 {
+			pagination = fmt.Sprintf(`{"older_url":"/v1/user/1/event?older_id=%d"}`, top-1)
+		}
+		fmt.Fprintf(w, `{"Response":[%s],"Pagination":%s}`, strings.Join(items, ","), pagination)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+
+	collect := func(pos *int, limit int) []int {
+		var ids []int
+		for e, err := range ListResumable(ctx, c.Event.List, nil, pos) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			ids = append(ids, e.ID)
+			if len(ids) == limit {
+				break
+			}
+		}
+		return ids
+	}
+
+	var pos int
+	if ids := collect(&pos, 3); !slices.Equal(ids, []int{6, 5, 4}) || pos != 4 {
+		t.Fatalf("first run: got %v at %d, want [6 5 4] at 4", ids, pos)
+	}
+	if ids := collect(&pos, 0); !slices.Equal(ids, []int{3, 2, 1}) || pos != 1 {
+		t.Fatalf("resumed run: got %v at %d, want [3 2 1] at 1", ids, pos)
+	}
+	if ids := collect(&pos, 0); len(ids) != 0 {
+		t.Errorf("exhausted run: got %v, want none", ids)
+	}
+}
+
+func TestWhitelistSddRecurring_CreateBody(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		json.NewDecoder(r.Body).Decode(&gotBody)
+		fmt.Fprint(w, `{"Response":[{"Id":{"id":9}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	id, err := c.WhitelistSddRecurring.Create(context.Background(), WhitelistSddRecurringCreateParams{
+		MonetaryAccountPayingID: 2,
+		RequestID:               77,
+		MaximumAmountPerMonth:   NewAmount(50, "EUR"),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != 9 {
+		t.Errorf("expected ID 9, got %d", id)
+	}
+	if gotPath != "/user/1/whitelist-sdd-recurring" {
+		t.Errorf("unexpected path %s", gotPath)
+	}
+	want := map[string]any{
+		"monetary_account_paying_id": float64(2),
+		"request_id":                 float64(77),
+		"maximum_amount_per_month":   map[string]any{"value": "50.00", "currency": "EUR"},
+	}
+	if fmt.Sprint(gotBody) != fmt.Sprint(want) {
+		t.Errorf("expected body %v, got %v", want, gotBody)
+	}
+}
+
+func TestEnvironmentForBaseURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want Environment
+		ok   bool
+	}{
+		{"https://api.bunq.com/v1", Production, true},
+		{"https://public-api.sandbox.bunq.com/v1", Sandbox, true},
+		{"https://public-api.sandbox.bunq.com/v1/", Sandbox, true},
+		{"https://example.com/v1", Environment{}, false},
+		{"", Environment{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := EnvironmentForBaseURL(tt.url)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("EnvironmentForBaseURL(%q) = %v, %v; want %v, %v", tt.url, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestNewClient_SandboxKeyInProduction(t *testing.T) {
+	_, err := NewClient(context.Background(), Config{APIKey: "sandbox_abc", Environment: Production})
+	if !errors.Is(err, ErrEnvironmentMismatch) {
+		t.Fatalf("expected ErrEnvironmentMismatch, got %v", err)
+	}
+}
+
+func TestResponseObjectEnvelope(t *testing.T) {
+	body := []byte(`{"Response":{"Payment":{"id":7}},"Pagination":{"older_url":"/v1/user/1/monetary-account/2/payment?older_id=7"}}`)
+
+	p, err := unmarshalObject[Payment](body, "Payment")
+	if err != nil || p.ID != 7 {
+		t.Errorf("unmarshalObject: got %+v, %v", p, err)
+	}
+
+	list, err := unmarshalList[Payment](body, "Payment")
+	if err != nil || len(list.Items) != 1 || list.Items[0].ID != 7 || list.Pagination == nil {
+		t.Errorf("unmarshalList: got %+v, %v", list, err)
+	}
+
+	var ids []int
+	pagination, err := decodeList(body, "Payment", func(p Payment) bool {
+		ids = append(ids, p.ID)
+		return true
+	})
+	if err != nil || !slices.Equal(ids, []int{7}) || pagination == nil || pagination.OlderURL == "" {
+		t.Errorf("decodeList: got %v, %+v, %v", ids, pagination, err)
+	}
+
+	if id, err := unmarshalID([]byte(`{"Response":{"Id":{"id":42}}}`)); err != nil || id != 42 {
+		t.Errorf("unmarshalID: got %d, %v", id, err)
+	}
+
+	// Arrays and null keep their meaning.
+	if _, err := unmarshalObject[Payment]([]byte(`{"Response":[]}`), "Payment"); !errors.Is(err, ErrNoResult) {
+		t.Errorf("expected ErrNoResult for an empty array, got %v", err)
+	}
+	if _, err := unmarshalObject[Payment]([]byte(`{"Response":null}`), "Payment"); err == nil || errors.Is(err, ErrNoResult) {
+		t.Errorf("expected missing Response error for null, got %v", err)
+	}
+}
+
+func TestDecodeList(t *testing.T) {
+	body := `{"Response":[{"Payment":{"id":1}},{"Other":{"id":9}},{"Payment":{"id":2}}],"Pagination":{"older_url":"/v1/user/1/monetary-account/2/payment?older_id=100&count=10"}}`
+	var ids []int
+	pagination, err := decodeList([]byte(body), "Payment", func(p Payment) bool {
+		ids = append(ids, p.ID)
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(ids, []int{1, 2}) {
+		t.Errorf("expected [1 2], got %v", ids)
+	}
+	if id, ok := pagination.olderID(); !ok || id != 100 {
+		t.Errorf("expected older_id 100, got %d", id)
+	}
+
+	ids = nil
+	if _, err := decodeList([]byte(body), "Payment", func(p Payment) bool {
+		ids = append(ids, p.ID)
+		return false
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(ids, []int{1}) {
+		t.Errorf("expected to stop after [1], got %v", ids)
+	}
+
+	if _, err := decodeList([]byte(`{"Response":null}`), "Payment", func(Payment) bool { return true }); err != nil {
+		t.Errorf("unexpected error for null Response: %v", err)
+	}
+	if _, err := decodeList([]byte(`{"Response":[{"Payment":{"id":"x"}}]}`), "Payment", func(Payment) bool { return true }); err == nil {
+		t.Error("expected error for malformed item")
+	}
+}
+
+// listBenchmarkBody is a 200-item page of payments, the largest page bunq
+// returns.
+func listBenchmarkBody() []byte {
+	var b strings.Builder
+	b.WriteString(`{"Response":[`)
+	for i := range 200 {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, `{"Payment":{"id":%d,"created":"2024-01-15 10:30:00.000000","description":"%s","amount":{"value":"10.00","currency":"EUR"}}}`, i+1, strings.Repeat("x", 2000))
+	}
+	b.WriteString(`],"Pagination":{"older_url":null}}`)
+	return []byte(b.String())
+}
+
+func BenchmarkUnmarshalList(b *testing.B) {
+	body := listBenchmarkBody()
+	b.ReportAllocs()
+	for b.Loop() {
+		resp, err := unmarshalList[Payment](body, "Payment")
+		if err != nil || len(resp.Items) != 200 {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeList(b *testing.B) {
+	body := listBenchmarkBody()
+	b.ReportAllocs()
+	for b.Loop() {
+		n := 0
+		if _, err := decodeList(body, "Payment", func(Payment) bool { n++; return true }); err != nil || n != 200 {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestNewTestClient(t *testing.T) {
+	var gotAuth, gotSig string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("X-Bunq-Client-Authentication")
+		gotSig = r.Header.Get("X-Bunq-Client-Signature")
+		fmt.Fprint(w, `{"Response":[{"Id":{"id":3}}]}`)
+	}))
+	defer srv.Close()
+
+	c := NewTestClient(srv.Client(), srv.URL, WithTestUserID(5), WithTestPrimaryMonetaryAccountID(6))
+	if c.UserID() != 5 || c.PrimaryMonetaryAccountID() != 6 {
+		t.Errorf("expected user 5 and account 6, got %d and %d", c.UserID(), c.PrimaryMonetaryAccountID())
+	}
+	if _, err := c.Payment.Create(context.Background(), 0, PaymentCreateParams{Description: "x"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotAuth != "test-session" || gotSig == "" {
+		t.Errorf("expected a signed request with the test session, got auth %q sig %q", gotAuth, gotSig)
+	}
+}
+
+func TestSessionRefreshThreshold(t *testing.T) {
+	var refreshes atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/session-server" {
+			refreshes.Add(1)
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":1}},{"Token":{"token":"refreshed"}},{"UserPerson":{"id":1,"session_timeout":3600}}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"Response":[{"Card":{"id":3}}]}`)
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := newMockClient(srv)
+	c.sessionExpiry = time.Now().Add(2 * time.Minute)
+
+	// The default threshold of 30s leaves a 2-minute session alone.
+	if _, err := c.Card.Get(ctx, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n := refreshes.Load(); n != 0 {
+		t.Fatalf("expected no refresh, got %d", n)
+	}
+
+	c.cfg.SessionRefreshThreshold = 5 * time.Minute
+	for range 2 {
+		if _, err := c.Card.Get(ctx, 3); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if n := refreshes.Load(); n != 1 {
+		t.Errorf("expected 1 refresh, got %d", n)
+	}
+	if c.sessionToken != "refreshed" {
+		t.Errorf("expected refreshed token, got %q", c.sessionToken)
+	}
+}
+
+func TestSessionRefreshThreshold_Capped(t *testing.T) {
+	var refreshes atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/session-server" {
+			refreshes.Add(1)
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":1}},{"Token":{"token":"refreshed"}},{"UserPerson":{"id":1,"session_timeout":600}}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"Response":[{"Card":{"id":3}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	clock := newFakeClock()
+	clock.install(c)
+	c.cfg.SessionRefreshThreshold = time.Hour // longer than the session lives
+	c.sessionExpiry = clock.Now()
+
+	for range 3 {
+		if _, err := c.Card.Get(context.Background(), 3); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if n := refreshes.Load(); n != 1 {
+		t.Errorf("expected 1 refresh, got %d", n)
+	}
+	if got := c.sessionRefreshThreshold(); got != 5*time.Minute {
+		t.Errorf("threshold = %v, want half the 10-minute session", got)
+	}
+}
+
+func TestAnchorObject_Dispatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/user/1/monetary-account":
+			fmt.Fprint(w, `{"Response":[{"MonetaryAccountBank":{"id":2,"description":"main"}},{"MonetaryAccountSavings":{"id":3,"description":"savings"}}]}`)
+		case "/user/1":
+			fmt.Fprint(w, `{"Response":[{"UserCompany":{"id":1,"name":"ACME"}}]}`)
+		}
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+
+	var got []string
+	for a, err := range c.MonetaryAccount.List(ctx, nil) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		switch o := a.Object().(type) {
+		case *MonetaryAccountBank:
+			got = append(got, fmt.Sprintf("bank %d", o.ID))
+		case *MonetaryAccountSavings:
+			got = append(got, fmt.Sprintf("savings %d", o.ID))
+		default:
+			t.Errorf("unexpected object %T", o)
+		}
+	}
+	if !slices.Equal(got, []string{"bank 2", "savings 3"}) {
+		t.Errorf("expected [bank 2 savings 3], got %v", got)
+	}
+
+	user, err := c.User.Get(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if company, ok := user.Object().(*UserCompany); !ok || company.Name != "ACME" {
+		t.Errorf("expected *UserCompany ACME, got %#v", user.Object())
+	}
+}
+
+func TestPaymentAutoAllocate_CreateRuleBody(t *testing.T) {
+	var gotPath, gotBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		b, _ := io.ReadAll(r.Body)
+		gotBody = string(b)
+		fmt.Fprint(w, `{"Response":[{"Id":{"id":4}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	savings, err := NewIBANPointer("NL91ABNA0417164300", "J. Doe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := c.PaymentAutoAllocate.CreateRule(context.Background(), 0, PaymentAutoAllocateRuleParams{
+		PaymentID: 11,
+		Type:      PaymentAutoAllocateTypePercentage,
+		Definition: []PaymentAutoAllocateTarget{
+			{CounterpartyAlias: savings, Description: "savings", Fraction: 0.25},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id != 4 {
+		t.Errorf("expected ID 4, got %d", id)
+	}
+	if gotPath != "/user/1/monetary-account/2/payment-auto-allocate" {
+		t.Errorf("unexpected path %s", gotPath)
+	}
+	want := `{"payment_id":11,"type":"PERCENTAGE","definition":[{"counterparty_alias":{"type":"IBAN","value":"NL91ABNA0417164300","name":"J. Doe"},"description":"savings","fraction":0.25}]}`
+	if gotBody != want {
+		t.Errorf("unexpected body:\n got %s\nwant %s", gotBody, want)
+	}
+
+	_, err = c.PaymentAutoAllocate.CreateRule(context.Background(), 0, PaymentAutoAllocateRuleParams{
+		PaymentID:  11,
+		Type:       PaymentAutoAllocateTypeAmount,
+		Definition: []PaymentAutoAllocateTarget{{Description: "missing", Amount: NewAmount(5, "EUR")}},
+	})
+	if err == nil {
+		t.Error("expected error for target without counterparty")
+	}
+}
+
+func TestPaymentAutoAllocateInstance_List(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"Response":[{"PaymentAutoAllocateInstance":{"id":8,"payment_auto_allocate_id":4,"status":"SUCCEEDED","payment_batch":{"payments":[{"id":21,"amount":{"value":"-2.50","currency":"EUR"}}]}}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	for inst, err := range c.PaymentAutoAllocateInstance.List(context.Background(), 0, 4, nil) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if inst.PaymentBatch == nil || len(inst.PaymentBatch.Payments) != 1 || inst.PaymentBatch.Payments[0].Amount.Value != "-2.50" {
+			t.Errorf("unexpected allocation %+v", inst)
+		}
+	}
+}
+
+func TestWithGeolocation(t *testing.T) {
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("X-Bunq-Geolocation"))
+		fmt.Fprint(w, `{"Response":[{"Id":{"id":1}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+	if _, err := c.Payment.Create(ctx, 0, PaymentCreateParams{}); err != nil {
+		t.Fatal(err)
+	}
+	geoCtx := WithGeolocation(ctx, 52.3702, 4.8952, 12.5, 100, "nl")
+	if _, err := c.Payment.Create(geoCtx, 0, PaymentCreateParams{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"0 0 0 0 NL", "4.8952 52.3702 12.5 100 NL"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected headers %q, got %q", want, got)
+	}
+}
+
+func TestTotalBalance(t *testing.T) {
+	accounts := `{"MonetaryAccountBank":{"id":2,"status":"ACTIVE","balance":{"value":"100.50","currency":"EUR"}}},` +
+		`{"MonetaryAccountSavings":{"id":3,"status":"ACTIVE","balance":{"value":"1000.00","currency":"EUR"}}},` +
+		`{"MonetaryAccountJoint":{"id":4,"status":"CANCELLED","balance":{"value":"5.00","currency":"EUR"}}},` +
+		`{"MonetaryAccountBank":{"id":5,"status":"ACTIVE","balance":{"value":"20.00","currency":"USD"}}}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"Response":[%s]}`, accounts)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+
+	if _, err := c.TotalBalance(ctx, "EUR", nil); err == nil || !strings.Contains(err.Error(), "account 5") {
+		t.Errorf("expected currency mismatch error for account 5, got %v", err)
+	}
+
+	total, err := c.TotalBalance(ctx, "eur", &TotalBalanceOptions{SkipOtherCurrencies: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if total.Value != "1100.50" || total.Currency != "EUR" {
+		t.Errorf("expected 1100.50 EUR, got %s %s", total.Value, total.Currency)
+	}
+
+	if _, err := c.TotalBalance(ctx, "EURO", nil); err == nil {
+		t.Error("expected an error for an invalid currency")
+	}
+}
+
+func TestNullable(t *testing.T) {
+	type params struct {
+		Description NullableString `json:"description,omitzero"`
+		Limit       NullableAmount `json:"limit,omitzero"`
+	}
+
+	tests := []struct {
+		name string
+		in   params
+		want string
+	}{
+		{"unset", params{}, `{}`},
+		{"set", params{
+			Description: NewNullable("rent"),
+			Limit:       NewNullable(NewAmount(10, "EUR")),
+		}, `{"description":"rent","limit":{"value":"10.00","currency":"EUR"}}`},
+		{"cleared", params{
+			Description: Null[string](),
+			Limit:       Null[*Amount](),
+		}, `{"description":null,"limit":null}`},
+		{"set to empty", params{Description: NewNullable("")}, `{"description":""}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := marshalBody(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("got %s, want %s", b, tt.want)
+			}
+
+			var back params
+			if err := json.Unmarshal(b, &back); err != nil {
+				t.Fatal(err)
+			}
+			if back.Description.IsNull() != tt.in.Description.IsNull() || back.Description.IsZero() != tt.in.Description.IsZero() {
+				t.Errorf("round trip changed state: %+v", back.Description)
+			}
+			got, _ := back.Description.Get()
+			want, _ := tt.in.Description.Get()
+			if got != want {
+				t.Errorf("round trip changed value: %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestChat(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(b))
+		switch {
+		case r.Method == http.MethodPost:
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":12}}]}`)
+		case r.URL.Path == "/user/1/chat-conversation/12/message":
+			fmt.Fprint(w, `{"Response":[`+
+				`{"ChatMessageUser":{"id":31,"created":"2024-01-15 10:30:00.000000","conversation_id":12,"creator":{"display_name":"J. Doe"},"content":{"ChatMessageContentText":{"text":"Thanks!"}}}},`+
+				`{"ChatMessageStatus":{"id":30,"conversation_id":12}}]}`)
+		}
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+
+	chatID, err := c.Chat.CreateForPayment(ctx, 0, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Chat.SendText(ctx, chatID, "Thanks!"); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`POST /user/1/monetary-account/2/payment/7/chat {}`,
+		`POST /user/1/chat-conversation/12/message-text {"text":"Thanks!"}`,
+	}
+	if !slices.Equal(requests, want) {
+		t.Errorf("expected requests %q, got %q", want, requests)
+	}
+
+	var got []string
+	for m, err := range c.Chat.ListMessages(ctx, chatID, nil) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		d := m.Message()
+		got = append(got, fmt.Sprintf("%d %q", d.ID, d.Text()))
+		if m.ChatMessageUser != nil && (d.Creator.DisplayName != "J. Doe" || d.Created == "") {
+			t.Errorf("unexpected message %+v", d)
+		}
+	}
+	if !slices.Equal(got, []string{`31 "Thanks!"`, `30 ""`}) {
+		t.Errorf("unexpected messages %q", got)
+	}
+}
+
+func TestTabResults(t *testing.T) {
+	const tabUUID = "5d4b0a4c-8f2e-4b6c-9d1a-2f3e4a5b6c7d"
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		switch r.URL.Path {
+		case "/user/1/monetary-account/2/cash-register/4/tab/" + tabUUID + "/tab-result-inquiry":
+			fmt.Fprint(w, `{"Response":[{"TabResultInquiry":{"id":9,"tab":{"TabUsageSingle":{"uuid":"`+tabUUID+`","merchant_reference":"order-17","status":"PAID","amount_total":{"value":"12.50","currency":"EUR"}}},"payment":{"id":55,"amount":{"value":"12.50","currency":"EUR"}}}}],"Pagination":{}}`)
+		case "/user/1/monetary-account/3/tab-result-response/8":
+			fmt.Fprint(w, `{"Response":[{"TabResultResponse":{"id":8,"tab":{"TabUsageMultiple":{"uuid":"`+tabUUID+`"}},"payment":{"id":56}}}]}`)
+		}
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+
+	var results []TabResultInquiry
+	for r, err := range c.TabResultInquiry.List(ctx, 0, 4, tabUUID, nil) {
+		if err != nil {
+			t.Fatalf("listing tab results: %v", err)
+		}
+		results = append(results, r)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	r := results[0]
+	tab, ok := r.Tab.Object().(*Tab)
+	if !ok || tab.MerchantReference != "order-17" || tab.AmountTotal.Value != "12.50" {
+		t.Errorf("unexpected tab %+v", r.Tab)
+	}
+	if r.Payment == nil || r.Payment.ID != 55 {
+		t.Errorf("unexpected payment %+v", r.Payment)
+	}
+
+	resp, err := c.TabResultResponse.Get(ctx, 3, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Tab.Tab() == nil || resp.Tab.Tab().UUID != tabUUID || resp.Payment.ID != 56 {
+		t.Errorf("unexpected response %+v", resp)
+	}
+
+	for _, err := range c.TabResultInquiry.List(ctx, 0, 4, "not-a-uuid", nil) {
+		if err == nil {
+			t.Error("expected error for invalid tab UUID")
+		}
+	}
+	if len(paths) != 2 {
+		t.Errorf("expected 2 requests, got %q", paths)
+	}
+}
+
+func TestEncryptRequests(t *testing.T) {
+	serverKey, err := generateRSAKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientKey, err := testKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// decrypt reverses the client encryption the way bunq does.
+	decrypt := func(h http.Header, body []byte) ([]byte, error) {
+		b64 := func(name string) []byte {
+			b, _ := base64.StdEncoding.DecodeString(h.Get(name))
+			return b
+		}
+		key, err := rsa.DecryptPKCS1v15(nil, serverKey, b64("X-Bunq-Client-Encryption-Key"))
+		if err != nil {
+			return nil, fmt.Errorf("decrypting key: %w", err)
+		}
+		iv := b64("X-Bunq-Client-Encryption-Iv")
+		mac := hmac.New(sha1.New, key)
+		mac.Write(iv)
+		mac.Write(body)
+		if !hmac.Equal(mac.Sum(nil), b64("X-Bunq-Client-Encryption-Hmac")) {
+			return nil, errors.New("HMAC mismatch")
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		if len(body) == 0 || len(body)%aes.BlockSize != 0 {
+			return nil, fmt.Errorf("ciphertext length %d", len(body))
+		}
+		plain := make([]byte, len(body))
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, body)
+		pad := int(plain[len(plain)-1])
+		return plain[:len(plain)-pad], nil
+	}
+
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := verifyResponse(&clientKey.PublicKey, body, r.Header.Get("X-Bunq-Client-Signature")); err != nil {
+			t.Errorf("signature does not cover the sent body: %v", err)
+		}
+		if r.Header.Get("X-Bunq-Client-Encryption-Key") == "" {
+			got = append(got, r.Method+" "+string(body))
+		} else {
+			plain, err := decrypt(r.Header, body)
+			if err != nil {
+				t.Errorf("decrypting request: %v", err)
+			}
+			got = append(got, r.Method+" encrypted "+string(plain))
+		}
+		fmt.Fprint(w, `{"Response":[{"Id":{"id":5}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	c.cfg.EncryptRequests = true
+	c.privateKey = clientKey
+	c.serverPublicKey = &serverKey.PublicKey
+	ctx := context.Background()
+
+	if _, err := c.Chat.SendText(ctx, 12, "Secret"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := c.get(ctx, "user/1", nil); err != nil {
+		t.Fatal(err)
+	}
+	c.cfg.EncryptRequests = false
+	if _, err := c.Chat.SendText(ctx, 12, "Plain"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`POST encrypted {"text":"Secret"}`,
+		`GET `,
+		`POST {"text":"Plain"}`,
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestNotificationFilterPush(t *testing.T) {
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/1/notification-filter-push" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		b, _ := io.ReadAll(r.Body)
+		if r.Method == http.MethodPost {
+			bodies = append(bodies, string(b))
+		}
+		fmt.Fprint(w, `{"Response":[{"NotificationFilterPush":{"notification_filters":[{"category":"MUTATION"},{"category":"CHAT"}]}}],"Pagination":{}}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+
+	res, err := c.NotificationFilterPush.Create(ctx, NotificationFilterPushCreateParams{
+		NotificationFilters: []*NotificationFilter{{Category: NotificationCategoryMutation}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.NotificationFilters) != 2 || res.NotificationFilters[1].Category != "CHAT" {
+		t.Errorf("unexpected filters %+v", res.NotificationFilters)
+	}
+	if _, err := c.NotificationFilterPush.Update(ctx, NotificationCategoryMutation, NotificationCategoryChat); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.NotificationFilterPush.Update(ctx); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`{"notification_filters":[{"category":"MUTATION"}]}`,
+		`{"notification_filters":[{"category":"MUTATION"},{"category":"CHAT"}]}`,
+		`{"notification_filters":[]}`,
+	}
+	if !slices.Equal(bodies, want) {
+		t.Errorf("expected bodies %q, got %q", want, bodies)
+	}
+
+	categories, err := c.NotificationFilterPush.Categories(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(categories, []string{"MUTATION", "CHAT"}) {
+		t.Errorf("unexpected categories %q", categories)
+	}
+}
+
+func TestRequestBodyHeaders(t *testing.T) {
+	clientKey, err := testKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type seen struct {
+		method        string
+		body          string
+		contentType   string
+		contentLength int64
+		signatureOK   bool
+	}
+	var got []seen
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		err := verifyResponse(&clientKey.PublicKey, b, r.Header.Get("X-Bunq-Client-Signature"))
+		got = append(got, seen{r.Method, string(b), r.Header.Get("Content-Type"), r.ContentLength, err == nil})
+		fmt.Fprint(w, `{"Response":[{"Id":{"id":1}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	c.privateKey = clientKey
+	ctx := context.Background()
+
+	if _, _, err := c.get(ctx, "user/1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.delete(ctx, "user/1/thing/2"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := c.post(ctx, "user/1/thing", map[string]string{"a": "b"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Requests without a body are signed over the empty string.
+	want := []seen{
+		{http.MethodGet, "", "", 0, true},
+		{http.MethodDelete, "", "", 0, true},
+		{http.MethodPost, `{"a":"b"}`, "application/json", 9, true},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestTopUp(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(b))
+		switch {
+		case r.Method == http.MethodPost:
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":8}}]}`)
+		case strings.Contains(r.URL.Path, "ideal-merchant-transaction"):
+			fmt.Fprint(w, `{"Response":[{"IdealMerchantTransaction":{"status":"PENDING","issuer_authentication_url":"https://ideal.example/auth/8"}}]}`)
+		default:
+			fmt.Fprint(w, `{"Response":[{"SofortMerchantTransaction":{"status":"PENDING"}}]}`)
+		}
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+
+	url, err := c.IdealMerchantTransaction.TopUp(ctx, 0, &Amount{Value: "25.00", Currency: "EUR"}, "INGBNL2A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if url != "https://ideal.example/auth/8" {
+		t.Errorf("unexpected redirect URL %q", url)
+	}
+
+	if _, err := c.SofortMerchantTransaction.TopUp(ctx, 3, &Amount{Value: "10.00", Currency: "EUR"}); err == nil || !strings.Contains(err.Error(), "no issuer authentication URL") {
+		t.Errorf("expected missing URL error, got %v", err)
+	}
+
+	want := []string{
+		`POST /user/1/monetary-account/2/ideal-merchant-transaction {"amount_requested":{"value":"25.00","currency":"EUR"},"issuer":"INGBNL2A"}`,
+		`GET /user/1/monetary-account/2/ideal-merchant-transaction/8 `,
+		`POST /user/1/monetary-account/3/sofort-merchant-transaction {"amount_requested":{"value":"10.00","currency":"EUR"}}`,
+		`GET /user/1/monetary-account/3/sofort-merchant-transaction/8 `,
+	}
+	if !slices.Equal(requests, want) {
+		t.Errorf("expected requests %q, got %q", want, requests)
+	}
+}
+
+func TestLimits(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/user/1/limit":
+			fmt.Fprint(w, `{"Response":[{"CustomerLimit":{"limit_monetary_account":25,"limit_monetary_account_remaining":20,"limit_amount_monthly":{"value":"1000.00","currency":"EUR"},"spent_amount_monthly":{"value":"900.00","currency":"EUR"}}}],"Pagination":{}}`)
+		case "/user/1/monetary-account/2":
+			fmt.Fprint(w, `{"Response":[{"MonetaryAccountBank":{"id":2,"daily_limit":{"value":"50.00","currency":"EUR"}}}]}`)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	l, err := c.Limits(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.DailyLimit.Value != "50.00" || l.MonthlyLimit.Value != "1000.00" || l.MonthlySpent.Value != "900.00" {
+		t.Errorf("unexpected limits %+v", l)
+	}
+	if l.Customer.LimitMonetaryAccountRemaining != 20 {
+		t.Errorf("expected 20 accounts remaining, got %d", l.Customer.LimitMonetaryAccountRemaining)
+	}
+
+	tests := []struct {
+		value string
+		ok    bool
+	}{
+		{"50.00", true},
+		{"50.01", false}, // over the daily limit
+	}
+	for _, tt := range tests {
+		err := l.Check(&Amount{Value: tt.value, Currency: "EUR"})
+		if tt.ok && err != nil {
+			t.Errorf("Check(%s): unexpected error %v", tt.value, err)
+		}
+		if !tt.ok && !errors.Is(err, ErrLimitExceeded) {
+			t.Errorf("Check(%s): expected ErrLimitExceeded, got %v", tt.value, err)
+		}
+	}
+
+	l.DailyLimit = nil
+	if err := l.Check(&Amount{Value: "100.01", Currency: "EUR"}); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("expected the remaining monthly limit of 100.00 to be exceeded, got %v", err)
+	}
+	if err := l.Check(&Amount{Value: "100.00", Currency: "EUR"}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestLimits_DailySpent(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/user/1/limit":
+			fmt.Fprint(w, `{"Response":[],"Pagination":{}}`)
+		case "/user/1/monetary-account/2":
+			fmt.Fprint(w, `{"Response":[{"MonetaryAccountJoint":{"id":2,"daily_limit":{"value":"1000.00","currency":"EUR"},"daily_spent":{"value":"750.50","currency":"EUR"}}}]}`)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	l, err := c.Limits(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.DailySpent == nil || l.DailySpent.Value != "750.50" {
+		t.Fatalf("DailySpent = %+v, want 750.50", l.DailySpent)
+	}
+	left, err := l.RemainingDailyLimit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if left.Value != "249.50" || left.Currency != "EUR" {
+		t.Errorf("RemainingDailyLimit = %+v, want 249.50 EUR", left)
+	}
+	if err := l.Check(&Amount{Value: "249.51", Currency: "EUR"}); !errors.Is(err, ErrLimitExceeded) {
+		t.Errorf("expected the remaining daily limit to be exceeded, got %v", err)
+	}
+	if err := l.Check(&Amount{Value: "249.50", Currency: "EUR"}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	l.DailySpent = &Amount{Value: "1200.00", Currency: "EUR"}
+	if left, err := l.RemainingDailyLimit(); err != nil || left.Value != "0.00" {
+		t.Errorf("overspent RemainingDailyLimit = %+v, %v; want 0.00", left, err)
+	}
+	l.DailySpent = nil
+	if left, err := l.RemainingDailyLimit(); err != nil || left.Value != "1000.00" {
+		t.Errorf("RemainingDailyLimit without DailySpent = %+v, %v; want 1000.00", left, err)
+	}
+	l.DailyLimit = nil
+	if left, err := l.RemainingDailyLimit(); err != nil || left != nil {
+		t.Errorf("RemainingDailyLimit without DailyLimit = %+v, %v; want nil", left, err)
+	}
+}
+
+func TestCard_AllowedNames(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/1/card-name" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"Response":[{"CardUserNameArray":{"possible_card_name_array":["J. DOE","JOHN DOE"]}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	names, err := c.Card.AllowedNames(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(names, []string{"J. DOE", "JOHN DOE"}) {
+		t.Errorf("unexpected names %q", names)
+	}
+}
+
+func TestScheduledPaymentResults(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/user/1/monetary-account/2/schedule-payment":
+			fmt.Fprint(w, `{"Response":[{"ScheduledPayment":{"id":5,"status":"ACTIVE"}},{"ScheduledPayment":{"id":6,"status":"FINISHED"}}],"Pagination":{}}`)
+		case "/user/1/monetary-account/2/schedule/5/schedule-instance":
+			fmt.Fprint(w, `{"Response":[{"ScheduledInstance":{"id":51,"state":"FINISHED_SUCCESSFULLY","result_object":{"Payment":{"id":77,"amount":{"value":"-10.00","currency":"EUR"}}}}}],"Pagination":{}}`)
+		case "/user/1/monetary-account/2/schedule/6/schedule-instance":
+			fmt.Fprint(w, `{"Response":[{"ScheduledInstance":{"id":61,"state":"FAILED_USER_ERROR","error_message":[{"error_description":"Insufficient balance."}]}}],"Pagination":{}}`)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	var results []ScheduledPaymentResult
+	for r, err := range c.ScheduledPaymentResult.ListAccount(context.Background(), 0) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		results = append(results, r)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	ok := results[0]
+	if ok.SchedulePaymentID != 5 || ok.State != ScheduleResultFinished {
+		t.Errorf("unexpected result %+v", ok)
+	}
+	if p := ok.Payment(); p == nil || p.ID != 77 || p.Amount.Value != "-10.00" {
+		t.Errorf("unexpected payment %+v", p)
+	}
+	failed := results[1]
+	if failed.SchedulePaymentID != 6 || failed.Payment() != nil || len(failed.ErrorMessage) != 1 {
+		t.Errorf("unexpected failed result %+v", failed)
+	}
+}
+
+func TestNewClient_BootstrapError(t *testing.T) {
+	serverKey, err := testKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverPEM, _ := json.Marshal(publicKeyToPEM(&serverKey.PublicKey))
+
+	failDevice, garbledDevice := false, false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/installation":
+			fmt.Fprintf(w, `{"Response":[{"Id":{"id":11}},{"Token":{"token":"installation"}},{"ServerPublicKey":{"server_public_key":%s}}]}`, serverPEM)
+		case "/device-server":
+			if failDevice {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"Error":[{"error_description":"Invalid IP"}]}`)
+				return
+			}
+			if garbledDevice {
+				fmt.Fprint(w, `{"Response":[]}`)
+				return
+			}
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":22}}]}`)
+		case "/session-server":
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"Error":[{"error_description":"Invalid API key"}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	cfg := Config{APIKey: "key", Environment: Environment{BaseURL: srv.URL}, HTTPClient: srv.Client()}
+	_, err = NewClient(context.Background(), cfg)
+	var bootErr *BootstrapError
+	if !errors.As(err, &bootErr) {
+		t.Fatalf("expected BootstrapError, got %T: %v", err, err)
+	}
+	if bootErr.Step != BootstrapStepSessionServer || bootErr.InstallationID != 11 || bootErr.DeviceID != 22 || bootErr.SessionID != 0 {
+		t.Errorf("unexpected error %+v", bootErr)
+	}
+	if !bootErr.DeviceRegistered() || !bootErr.DeviceAttempted {
+		t.Error("expected the device to be reported as registered")
+	}
+	var authErr *UnauthorizedError
+	if !errors.As(err, &authErr) || !strings.HasPrefix(err.Error(), "session-server: ") {
+		t.Errorf("expected wrapped session-server error, got %v", err)
+	}
+
+	failDevice = true
+	_, err = NewClient(context.Background(), cfg)
+	if !errors.As(err, &bootErr) || bootErr.Step != BootstrapStepDeviceServer || bootErr.DeviceRegistered() {
+		t.Errorf("unexpected error %+v", err)
+	}
+
+	// bunq accepted the device, but its ID could not be parsed.
+	failDevice, garbledDevice = false, true
+	_, err = NewClient(context.Background(), cfg)
+	if !errors.As(err, &bootErr) || bootErr.Step != BootstrapStepDeviceServer || bootErr.DeviceID != 0 || !bootErr.DeviceAttempted {
+		t.Errorf("expected an attempted device registration without ID, got %+v", bootErr)
+	}
+}
+
+func TestPaymentLocationAndChat(t *testing.T) {
+	body := []byte(`{"Response":[{"Payment":{"id":7,"geolocation":{"latitude":52.3676,"longitude":"4.9041","altitude":0,"radius":10},"allow_chat":true}}]}`)
+	p, err := unmarshalObject[Payment](body, "Payment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lat, lng, ok := p.Location()
+	if !ok || lat != 52.3676 || lng != 4.9041 {
+		t.Errorf("got location %v,%v (%v), want 52.3676,4.9041", lat, lng, ok)
+	}
+	if !p.ChatAllowed() {
+		t.Error("expected chat to be allowed")
+	}
+
+	body = []byte(`{"Response":[{"Payment":{"id":8,"geolocation":{"latitude":0,"longitude":0}}}]}`)
+	if p, err = unmarshalObject[Payment](body, "Payment"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, ok := p.Location(); ok {
+		t.Error("expected no location for 0,0")
+	}
+	if p.AllowChat != nil || p.ChatAllowed() {
+		t.Errorf("expected allow_chat to be unset, got %v", p.AllowChat)
+	}
+}
+
+func TestBootstrapRequestBodies(t *testing.T) {
+	key, err := testKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bodies := map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies[r.URL.Path] = string(b)
+		if sig, _ := signRequest(key, b); r.Header.Get("X-Bunq-Client-Signature") != sig {
+			t.Errorf("%s: signature does not match body", r.URL.Path)
+		}
+		switch r.URL.Path {
+		case "/device-server":
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":22}}]}`)
+		case "/session-server":
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":33}},{"Token":{"token":"session"}},{"UserPerson":{"id":44}}]}`)
+		}
+	}))
+	defer srv.Close()
+
+	c := newClient(Config{
+		APIKey:      "sandbox_key",
+		Description: "test",
+		AllowedIPs:  []string{"192.0.2.1", "192.0.2.2"},
+		Environment: Environment{BaseURL: srv.URL},
+		HTTPClient:  srv.Client(),
+	})
+	c.privateKey = key
+	c.installationToken = "installation"
+	ctx := context.Background()
+	if _, err := c.doDeviceServer(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := c.doSessionServer(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// These bytes are signed; changing them must be deliberate.
+	want := map[string]string{
+		"/device-server":  `{"description":"test","permitted_ips":["192.0.2.1","192.0.2.2"],"secret":"sandbox_key"}`,
+		"/session-server": `{"secret":"sandbox_key"}`,
+	}
+	for path, body := range want {
+		if bodies[path] != body {
+			t.Errorf("%s body:\n got %s\nwant %s", path, bodies[path], body)
+		}
+	}
+}
+
+func TestDeviceServer_ReusesRegisteredDevice(t *testing.T) {
+	pages := map[string]string{
+		"": `{"Response":[
+			{"DeviceServer":{"id":35,"description":"other","ip":"192.0.2.1","status":"ACTIVE"}},
+			{"DeviceServer":{"id":34,"description":"test","ip":"192.0.2.1","status":"BLOCKED"}}],
+			"Pagination":{"older_url":"/v1/device-server?count=200&older_id=34"}}`,
+		"34": `{"Response":[
+			{"DeviceServer":{"id":33,"description":"test","ip":"*","status":"ACTIVE"}},
+			{"DeviceServer":{"id":32,"description":"test","ip":"192.0.2.1","status":"ACTIVE"}}],
+			"Pagination":{}}`,
+	}
+	postError := `{"Error":[{"error_description":"Device already registered."}]}`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/device-server" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("X-Bunq-Client-Authentication") != "installation" {
+			t.Errorf("%s device-server: expected the installation token", r.Method)
+		}
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, postError)
+			return
+		}
+		fmt.Fprint(w, pages[r.URL.Query().Get("older_id")])
+	}))
+	defer srv.Close()
+
+	c := newClient(Config{
+		APIKey:      "key",
+		Description: "test",
+		AllowedIPs:  []string{"192.0.2.1"},
+		Environment: Environment{BaseURL: srv.URL},
+		HTTPClient:  srv.Client(),
+	})
+	c.installationToken = "installation"
+	id, err := c.doDeviceServerReused(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != 32 {
+		t.Errorf("got device %d, want the active device 32 with a matching description and IP on the second page", id)
+	}
+
+	if _, err := c.doDeviceServer(context.Background()); statusCode(err) != http.StatusBadRequest {
+		t.Errorf("a fresh installation must not fall back to a registered device, got %v", err)
+	}
+
+	c.cfg.AllowedIPs = []string{"192.0.2.9"}
+	if _, err := c.doDeviceServerReused(context.Background()); statusCode(err) != http.StatusBadRequest {
+		t.Errorf("expected the registration error without a matching device, got %v", err)
+	}
+
+	// "*" is compared literally, not as a wildcard.
+	c.cfg.AllowedIPs = nil
+	if id, err := c.doDeviceServerReused(context.Background()); err != nil || id != 33 {
+		t.Errorf("got device %d, %v; want the device registered for \"*\"", id, err)
+	}
+
+	postError = `{"Error":[{"error_description":"Description is too long."}]}`
+	if _, err := c.doDeviceServerReused(context.Background()); statusCode(err) != http.StatusBadRequest {
+		t.Errorf("expected other registration errors to be returned, got %v", err)
+	}
+}
+
+func TestNewClientFromPythonContext_OtherAPIKey(t *testing.T) {
+	var deviceCalls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/device-server":
+			deviceCalls.Add(1)
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":22}}]}`)
+		case "/session-server":
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":9}},{"Token":{"token":"other-session"}},{"UserPerson":{"id":1}}]}`)
+		case "/user/1/monetary-account":
+			fmt.Fprint(w, `{"Response":[{"MonetaryAccountBank":{"id":7,"status":"ACTIVE"}}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	path := writePythonContext(t, time.Now().Add(time.Hour))
+	c, err := NewClientFromPythonContext(context.Background(), path, Config{
+		APIKey:      "other_key",
+		Environment: Environment{BaseURL: srv.URL},
+		HTTPClient:  srv.Client(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deviceCalls.Load() != 1 || c.Bootstrap().DeviceID != 22 {
+		t.Errorf("expected a device registration for the other key, got %d calls and device %d", deviceCalls.Load(), c.Bootstrap().DeviceID)
+	}
+	if c.sessionToken != "other-session" {
+		t.Errorf("expected a session for the other key, got %q", c.sessionToken)
+	}
+}
+
+func TestMonetaryAccountJointCoOwners(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/1/monetary-account-joint" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"Response":[
+			{"MonetaryAccountJoint":{"id":3,"all_co_owner":[
+				{"alias":{"uuid":"u1","display_name":"Alice"},"status":"ACCEPTED"},
+				{"alias":{"uuid":"u2","display_name":"Bob"},"status":"PENDING"}]}},
+			{"MonetaryAccountJoint":{"id":4,"all_co_owner":[
+				{"alias":{"display_name":"Carol"},"status":"REVOKED"}]}}],"Pagination":{}}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	var pending []PendingCoOwner
+	for p, err := range c.MonetaryAccountJoint.ListPendingApprovals(context.Background(), nil) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		pending = append(pending, p)
+	}
+	if len(pending) != 1 {
+		t.Fatalf("expected 1 pending co-owner, got %d", len(pending))
+	}
+	p := pending[0]
+	if p.MonetaryAccountID != 3 || p.CoOwner.Alias.DisplayName != "Bob" || p.CoOwner.Status != CoOwnerPending {
+		t.Errorf("unexpected pending co-owner %+v", p)
+	}
+}
+
+func TestFindPrimaryAccount_WaitsForActive(t *testing.T) {
+	var polls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		polls++
+		status := "PENDING_ACTIVATION"
+		if polls >= 2 {
+			status = "ACTIVE"
+		}
+		fmt.Fprintf(w, `{"Response":[{"MonetaryAccountBank":{"id":55,"status":%q}}]}`, status)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	c.primaryMonetaryAccountID = 0
+	clock := newFakeClock()
+	clock.install(c)
+
+	if err := c.findPrimaryAccount(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if c.primaryMonetaryAccountID != 55 || polls != 2 {
+		t.Errorf("got account %d after %d polls, want 55 after 2", c.primaryMonetaryAccountID, polls)
+	}
+	if !slices.Equal(clock.slept, []time.Duration{time.Second}) {
+		t.Errorf("expected one 1s wait, got %v", clock.slept)
+	}
+
+	// The attempts are bounded.
+	polls = -10
+	c.cfg.PrimaryAccountAttempts = 3
+	c.cfg.PrimaryAccountPollInterval = 100 * time.Millisecond
+	clock.slept = nil
+	if err := c.findPrimaryAccount(context.Background()); err == nil || !strings.Contains(err.Error(), "after 3 attempts") {
+		t.Errorf("expected error after 3 attempts, got %v", err)
+	}
+	if polls != -7 || len(clock.slept) != 2 || clock.slept[0] != 100*time.Millisecond {
+		t.Errorf("got %d polls and waits %v, want 3 polls and two 100ms waits", polls+10, clock.slept)
+	}
+
+	// A done context stops the wait.
+	polls = -10
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := c.findPrimaryAccount(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestAmountUnmarshalNested(t *testing.T) {
+	type feeItem struct {
+		Amount      *Amount `json:"amount"`
+		Description string  `json:"description"`
+	}
+	var got struct {
+		Fees    []feeItem          `json:"fees"`
+		Amounts []*Amount          `json:"amounts"`
+		ByKind  map[string]*Amount `json:"by_kind"`
+		Plain   Amount             `json:"plain"`
+	}
+	data := `{
+		"fees":[{"amount":{"value":"0.50","currency":"EUR"},"description":"transfer"},{"amount":{"value":1.5,"currency":"EUR"},"description":"fx"}],
+		"amounts":[{"value":"-2.00","currency":"USD"},null],
+		"by_kind":{"card":{"value":3,"currency":"GBP"}},
+		"plain":{"value":null,"currency":"EUR"}}`
+	if err := json.Unmarshal([]byte(data), &got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Fees) != 2 || *got.Fees[0].Amount != (Amount{"0.50", "EUR"}) || *got.Fees[1].Amount != (Amount{"1.5", "EUR"}) {
+		t.Errorf("unexpected fees %+v", got.Fees)
+	}
+	if len(got.Amounts) != 2 || *got.Amounts[0] != (Amount{"-2.00", "USD"}) || got.Amounts[1] != nil {
+		t.Errorf("unexpected amounts %v", got.Amounts)
+	}
+	if a := got.ByKind["card"]; a == nil || *a != (Amount{"3", "GBP"}) {
+		t.Errorf("unexpected map amount %v", a)
+	}
+	if got.Plain != (Amount{"", "EUR"}) {
+		t.Errorf("unexpected plain amount %+v", got.Plain)
+	}
+
+	var bad Amount
+	if err := json.Unmarshal([]byte(`{"value":true,"currency":"EUR"}`), &bad); err == nil {
+		t.Error("expected error for boolean value")
+	}
+}
+
+func TestInvoiceByUser(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/1/invoice" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		fmt.Fprint(w, `{"Response":[{"Invoice":{
+			"id":12,"invoice_date":"2026-09-30","invoice_number":"B2026-0012","status":"PAID",
+			"total_vat_inclusive":{"value":"24.19","currency":"EUR"},
+			"total_vat_exclusive":{"value":"19.99","currency":"EUR"},
+			"total_vat":{"value":"4.20","currency":"EUR"},
+			"group":[
+				{"type":"SUBSCRIPTION","type_description":"Subscription","item":[
+					{"id":1,"billing_date":"2026-09-01","type_description":"bunq Pro","quantity":1,"vat":"0.21","total_vat_inclusive":{"value":"12.10","currency":"EUR"}}]},
+				{"type":"CARD","type_description":"Cards","item":[
+					{"id":2,"billing_date":"2026-09-14","type_description":"Metal card","quantity":"1","vat":0.21,"total_vat_inclusive":{"value":"12.09","currency":"EUR"}}]}]}}],"Pagination":{}}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	var invoices []InvoiceByUser
+	for inv, err := range c.InvoiceByUser.List(context.Background(), nil) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		invoices = append(invoices, inv)
+	}
+	if len(invoices) != 1 {
+		t.Fatalf("expected 1 invoice, got %d", len(invoices))
+	}
+	inv := invoices[0]
+	if inv.ID != 12 || inv.Status != "PAID" || inv.InvoiceDate != "2026-09-30" || inv.TotalVATInclusive.Value != "24.19" {
+		t.Errorf("unexpected invoice %+v", inv)
+	}
+	items := inv.Items()
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(items))
+	}
+	if items[0].TypeDescription != "bunq Pro" || items[0].VAT != 0.21 || items[1].Quantity != 1 || items[1].TotalVATInclusive.Value != "12.09" {
+		t.Errorf("unexpected items %+v %+v", items[0], items[1])
+	}
+}
+
+func TestPrimaryMonetaryAccountSubstitution(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		fmt.Fprint(w, `{"Response":[],"Pagination":{}}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	ctx := context.Background()
+	for _, acct := range []int{0, 5} {
+		for _, err := range c.Payment.List(ctx, acct, nil) {
+			t.Fatal(err)
+		}
+	}
+	want := []string{"/user/1/monetary-account/2/payment", "/user/1/monetary-account/5/payment"}
+	if !slices.Equal(paths, want) {
+		t.Errorf("expected paths %q, got %q", want, paths)
+	}
+
+	// Every generated path with a monetary account takes it through
+	// resolveMonetaryAccountID, so that 0 means the primary account.
+	src, err := os.ReadFile("services_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var scoped int
+	for i, line := range strings.Split(string(src), "\n") {
+		if !strings.Contains(line, "monetary-account/%d") {
+			continue
+		}
+		scoped++
+		if !strings.Contains(line, "s.client.resolveMonetaryAccountID(monetaryAccountID)") {
+			t.Errorf("services_gen.go:%d does not resolve the monetary account ID", i+1)
+		}
+	}
+	if scoped == 0 {
+		t.Error("expected monetary-account-scoped paths in services_gen.go")
+	}
+}
+
+func TestNewPSD2Client(t *testing.T) {
+	serverKey, err := testKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverPEM, _ := json.Marshal(publicKeyToPEM(&serverKey.PublicKey))
+	pspKey, err := generateRSAKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := PSD2Certificate{
+		Certificate: "-----BEGIN CERTIFICATE-----\nbGVhZg==\n-----END CERTIFICATE-----\n",
+		Chain: []string{
+			"-----BEGIN CERTIFICATE-----\naW50ZXJtZWRpYXRl\n-----END CERTIFICATE-----\n",
+			"-----BEGIN CERTIFICATE-----\ncm9vdA==\n-----END CERTIFICATE-----\n",
+		},
+		PrivateKey: pspKey,
+	}
+
+	var clientPEM string
+	var secrets []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		switch r.URL.Path {
+		case "/installation":
+			clientPEM, _ = body["client_public_key"].(string)
+			fmt.Fprintf(w, `{"Response":[{"Id":{"id":11}},{"Token":{"token":"installation"}},{"ServerPublicKey":{"server_public_key":%s}}]}`, serverPEM)
+		case "/payment-service-provider-credential":
+			if r.Header.Get("X-Bunq-Client-Authentication") != "installation" {
+				t.Errorf("expected the installation token, got %q", r.Header.Get("X-Bunq-Client-Authentication"))
+			}
+			if body["client_payment_service_provider_certificate"] != cert.Certificate {
+				t.Errorf("unexpected certificate %v", body["client_payment_service_provider_certificate"])
+			}
+			wantChain := "-----BEGIN CERTIFICATE-----\naW50ZXJtZWRpYXRl\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\ncm9vdA==\n-----END CERTIFICATE-----"
+			if body["client_payment_service_provider_certificate_chain"] != wantChain {
+				t.Errorf("unexpected chain %q", body["client_payment_service_provider_certificate_chain"])
+			}
+			sig, _ := body["client_public_key_signature"].(string)
+			if err := verifyResponse(&pspKey.PublicKey, []byte(clientPEM+cert.Certificate), sig); err != nil {
+				t.Errorf("client public key signature does not verify: %v", err)
+			}
+			fmt.Fprint(w, `{"Response":[{"CredentialPasswordIp":{"id":5,"status":"ACTIVE","token_value":"psp-token"}}]}`)
+		case "/device-server":
+			secrets = append(secrets, body["secret"].(string))
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":22}}]}`)
+		case "/session-server":
+			secrets = append(secrets, body["secret"].(string))
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":33}},{"Token":{"token":"session"}},{"UserPaymentServiceProvider":{"id":44}}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	cfg := Config{Environment: Environment{BaseURL: srv.URL}, HTTPClient: srv.Client()}
+	c, err := NewPSD2Client(context.Background(), cfg, cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.UserID() != 44 || c.Bootstrap().UserType != "UserPaymentServiceProvider" {
+		t.Errorf("unexpected user %d (%s)", c.UserID(), c.Bootstrap().UserType)
+	}
+	if !slices.Equal(secrets, []string{"psp-token", "psp-token"}) {
+		t.Errorf("expected the credential token as secret, got %q", secrets)
+	}
+
+	cfg.APIKey = "key"
+	if _, err := NewPSD2Client(context.Background(), cfg, cert); err == nil {
+		t.Error("expected error for a config with an API key")
+	}
+}
+
+func TestPaymentAdditionalTransactionInformation(t *testing.T) {
+	body := []byte(`{"Response":[{"Payment":{"id":7,"additional_transaction_information":{
+		"category":"GROCERIES","merchant_category_code":"5411","merchant_name":"Albert Heijn",
+		"geolocation":{"latitude":52.37,"longitude":4.89}}}}]}`)
+	p, err := unmarshalObject[Payment](body, "Payment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := p.AdditionalTransactionInformation
+	if info == nil || info.MerchantCategoryCode != "5411" || info.MerchantName != "Albert Heijn" || info.Geolocation.Latitude != 52.37 {
+		t.Errorf("unexpected information %+v", info)
+	}
+	if p.Category() != "GROCERIES" {
+		t.Errorf("expected category GROCERIES, got %q", p.Category())
+	}
+
+	if p := (&Payment{}); p.Category() != "" {
+		t.Errorf("expected no category, got %q", p.Category())
+	}
+}
+
+func TestSessionRefreshRetry(t *testing.T) {
+	var refreshes int
+	failStatus := http.StatusServiceUnavailable
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/session-server" {
+			refreshes++
+			if refreshes == 1 {
+				w.WriteHeader(failStatus)
+				fmt.Fprint(w, `{"Error":[{"error_description":"Try again"}]}`)
+				return
+			}
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":1}},{"Token":{"token":"refreshed"}},{"UserPerson":{"id":1}}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"Response":[{"Card":{"id":3}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	clock := newFakeClock()
+	clock.install(c)
+	c.sessionExpiry = clock.Now()
+	ctx := context.Background()
+
+	if _, err := c.Card.Get(ctx, 3); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if refreshes != 2 || c.sessionToken != "refreshed" {
+		t.Errorf("got %d refreshes and token %q, want 2 and refreshed", refreshes, c.sessionToken)
+	}
+	if !slices.Equal(clock.slept, []time.Duration{time.Second}) {
+		t.Errorf("expected one 1s wait, got %v", clock.slept)
+	}
+
+	// A rejected refresh is not retried.
+	refreshes = 0
+	failStatus = http.StatusUnauthorized
+	clock.slept = nil
+	c.sessionExpiry = clock.Now()
+	var authErr *UnauthorizedError
+	if _, err := c.Card.Get(ctx, 3); !errors.As(err, &authErr) {
+		t.Errorf("expected UnauthorizedError, got %v", err)
+	}
+	if refreshes != 1 || len(clock.slept) != 0 {
+		t.Errorf("got %d refreshes and waits %v, want 1 and none", refreshes, clock.slept)
+	}
+
+	for _, err := range []error{
+		fmt.Errorf("no session token in response"),
+		fmt.Errorf("session user ID changed from 1 to 2"),
+	} {
+		if sessionRefreshRetryable(err) {
+			t.Errorf("%v: expected a local failure not to be retried", err)
+		}
+	}
+	if !sessionRefreshRetryable(fmt.Errorf("executing request: %w", &url.Error{Op: "Post", URL: "x", Err: io.ErrUnexpectedEOF})) {
+		t.Error("expected a transport error to be retried")
+	}
+}
+
+func TestSessionRefreshRetry_Unlocked(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, `{"Error":[{"error_description":"Try again"}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	c.cfg.SessionRefreshAttempts = 2
+	c.sessionExpiry = time.Now()
+	c.sleepFunc = func(ctx context.Context, d time.Duration) error {
+		// Another goroutine must be able to take the lock during the wait.
+		if !c.mu.TryLock() {
+			t.Error("session lock held while waiting to retry")
+			return nil
+		}
+		c.mu.Unlock()
+		return nil
+	}
+	if err := c.ensureSessionActive(context.Background()); statusCode(err) != http.StatusServiceUnavailable {
+		t.Errorf("expected the 503 after the last attempt, got %v", err)
+	}
+}
+
+func TestServicesDescriptor(t *testing.T) {
+	data, err := os.ReadFile("services_gen.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var services []struct {
+		Name    string `json:"name"`
+		Type    string `json:"type"`
+		Methods []struct {
+			Name       string `json:"name"`
+			HTTPMethod string `json:"http_method"`
+			Path       string `json:"path"`
+			Params     []struct {
+				Name string `json:"name"`
+				Type string `json:"type"`
+			} `json:"params"`
+			Body    string `json:"body"`
+			Returns string `json:"returns"`
+		} `json:"methods"`
+	}
+	if err := json.Unmarshal(data, &services); err != nil {
+		t.Fatal(err)
+	}
+	if len(services) != len(endpointRegistry) {
+		t.Errorf("got %d services, want %d as in endpointRegistry", len(services), len(endpointRegistry))
+	}
+
+	found := false
+	for _, s := range services {
+		meta := endpointRegistry[s.Type]
+		for _, m := range s.Methods {
+			if want := map[string]string{"Create": meta.Create, "Get": meta.Read, "List": meta.List, "Update": meta.Update, "Delete": meta.Delete}[m.Name]; m.Path != want {
+				t.Errorf("%s.%s path = %q, endpointRegistry has %q", s.Name, m.Name, m.Path, want)
+			}
+			if s.Name == "PaymentService" && m.Name == "Create" {
+				found = true
+				if m.HTTPMethod != "POST" || m.Path != "user/{}/monetary-account/{}/payment" {
+					t.Errorf("Payment.Create = %s %s", m.HTTPMethod, m.Path)
+				}
+				if len(m.Params) != 1 || m.Params[0].Name != "monetaryAccountID" || m.Params[0].Type != "int" {
+					t.Errorf("Payment.Create params = %+v, want [monetaryAccountID int]", m.Params)
+				}
+				if m.Body != "PaymentCreateParams" || m.Returns != "int" {
+					t.Errorf("Payment.Create body = %q, returns = %q", m.Body, m.Returns)
+				}
+			}
+		}
+	}
+	if !found {
+		t.Error("services_gen.json has no PaymentService.Create")
+	}
+}
+
+func TestServerTimeSkew(t *testing.T) {
+	serverTime := fakeClockStart.Add(90 * time.Second)
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Date", serverTime.Format(http.TimeFormat))
+		if r.URL.Path == "/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `{"Response":[{"Card":{"id":3}}]}`)
+	}))
+	defer srv.Close()
+
+	c := newMockClient(srv)
+	newFakeClock().install(c)
+
+	if _, ok := c.LastServerTimeSkew(); ok {
+		t.Error("expected no skew before any response")
+	}
+
+	skew, err := c.ServerTimeSkew(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if skew != 90*time.Second {
+		t.Errorf("skew = %v, want 1m30s", skew)
+	}
+
+	serverTime = fakeClockStart.Add(-2 * time.Second)
+	if _, err := c.Card.Get(context.Background(), 3); err != nil {
+		t.Fatal(err)
+	}
+	if skew, ok := c.LastServerTimeSkew(); !ok || skew != -2*time.Second {
+		t.Errorf("LastServerTimeSkew = %v, %v; want -2s, true", skew, ok)
+	}
+	if !slices.Equal(paths, []string{"/", "/user/1/card/3"}) {
+		t.Errorf("paths = %v", paths)
+	}
+}
+
+func TestExportStatementDownload(t *testing.T) {
+	var accept string
+	contentType := ""
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/1/monetary-account/2/customer-statement/7/content" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		accept = r.Header.Get("Accept")
+		w.Header().Set("Content-Type", contentType)
+		fmt.Fprint(w, "statement")
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+
+	for format, mediaType := range map[string]string{
+		StatementFormatPDF:   "application/pdf",
+		StatementFormatCSV:   "text/csv",
+		StatementFormatMT940: "application/x-mt940",
+	} {
+		contentType = mediaType + "; charset=utf-8"
+		data, err := c.ExportStatement.Download(context.Background(), 0, 7, format)
+		if err != nil {
+			t.Errorf("%s: %v", format, err)
+			continue
+		}
+		if accept != mediaType {
+			t.Errorf("%s: Accept = %q, want %q", format, accept, mediaType)
+		}
+		if string(data) != "statement" {
+			t.Errorf("%s: data = %q", format, data)
+		}
+	}
+
+	contentType = "application/json"
+	if _, err := c.ExportStatement.Download(context.Background(), 0, 7, StatementFormatPDF); !errors.Is(err, ErrUnexpectedContentType) {
+		t.Errorf("expected ErrUnexpectedContentType, got %v", err)
+	}
+	if _, err := c.ExportStatement.Download(context.Background(), 0, 7, "XLSX"); err == nil {
+		t.Error("expected error for an unknown format")
+	}
+}
+
+func TestListAll(t *testing.T) {
+	types := ListableTypes()
+	for _, name := range []string{"Payment", "Card"} {
+		if !slices.Contains(types, name) {
+			t.Errorf("%s is not listable", name)
+		}
+	}
+	if slices.Contains(types, "ExportStatementContent") {
+		t.Error("ExportStatementContent needs a statement ID and cannot be listed by account")
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/user/1/monetary-account/2/payment":
+			fmt.Fprint(w, `{"Response":[{"Payment":{"id":1}},{"Payment":{"id":2}}],"Pagination":{}}`)
+		case "/user/1/card":
+			fmt.Fprint(w, `{"Response":[{"Card":{"id":3}}],"Pagination":{}}`)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+
+	var ids []int
+	for item, err := range c.ListAll(context.Background(), "Payment", 0, nil) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, item.(Payment).ID)
+	}
+	if !slices.Equal(ids, []int{1, 2}) {
+		t.Errorf("payment IDs = %v", ids)
+	}
+
+	n := 0
+	for item, err := range c.ListAll(context.Background(), "Card", 0, nil) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := item.(Card); !ok {
+			t.Errorf("got %T, want Card", item)
+		}
+		n++
+	}
+	if n != 1 {
+		t.Errorf("got %d cards, want 1", n)
+	}
+
+	for _, err := range c.ListAll(context.Background(), "NoSuchType", 0, nil) {
+		if err == nil {
+			t.Error("expected error for an unknown type")
+		}
+	}
+}
+
+func TestUpdateResult(t *testing.T) {
+	var response string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("unexpected method %s", r.Method)
+		}
+		fmt.Fprint(w, response)
+	}))
+	defer srv.Close()
+	c := newMockClient(srv)
+	ctx := context.Background()
+
+	// bunq answers some updates with the object...
+	response = `{"Response":[{"Card":{"id":5,"status":"DEACTIVATED"}}]}`
+	res, err := c.Card.Update(ctx, 5, CardUpdateParams{Status: "DEACTIVATED"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ID != 5 || res.Object == nil || res.Object.Status != "DEACTIVATED" {
+		t.Errorf("object response: got %+v", res)
+	}
+
+	// ...and others with only the ID, whatever the Python SDK expects.
+	response = `{"Response":[{"Id":{"id":5}}]}`
+	if res, err = c.Card.Update(ctx, 5, CardUpdateParams{Status: "ACTIVE"}); err != nil {
+		t.Fatal(err)
+	}
+	if res.ID != 5 || res.Object != nil {
+		t.Errorf("ID response: got %+v", res)
+	}
+
+	response = `{"Response":[{"PaymentBatch":{"id":9}}]}`
+	batch, err := c.PaymentBatch.Update(ctx, 0, 9, PaymentBatchUpdateParams{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if batch.ID != 9 || batch.Object == nil {
+		t.Errorf("object response for an ID endpoint: got %+v", batch)
+	}
+
+	response = `{"Response":[{"Other":{"id":1}}]}`
+	if _, err := c.PaymentBatch.Update(ctx, 0, 9, PaymentBatchUpdateParams{}); err == nil {
+		t.Error("expected error without an ID or object")
+	}
+}
+
+func TestParseSessionResponse_RejectedKeepsSession(t *testing.T) {
+	c := newClient(Config{})
+	c.userID = 1
+	c.sessionToken = "old"
+	expiry := time.Now().Add(time.Minute)
+	c.sessionExpiry = expiry
+
+	for _, body := range []string{
+		`{"Response":[{"Id":{"id":9}},{"Token":{"token":"other"}},{"UserPerson":{"id":2}}]}`,
+		`{"Response":[{"Id":{"id":9}},{"Token":{"token":"other"}}]}`,
+	} {
+		if _, _, err := c.parseSessionResponse([]byte(body)); err == nil {
+			t.Errorf("expected error for %s", body)
+		}
+		if c.sessionToken != "old" || !c.sessionExpiry.Equal(expiry) || c.userID != 1 {
+			t.Errorf("rejected response replaced the session: token %q, expiry %v, user %d", c.sessionToken, c.sessionExpiry, c.userID)
 		}
 	}
 }
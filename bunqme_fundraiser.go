@@ -0,0 +1,41 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// The Python SDK can only read bunq.me fundraiser profiles and fetch single
+// results, so creating a profile and listing its results are maintained by
+// hand here.
+
+// BunqMeFundraiserProfileCreateParams holds the fields for creating a bunq.me
+// fundraiser profile, a standing donation page for a monetary account.
+type BunqMeFundraiserProfileCreateParams struct {
+	Pointer              *Pointer `json:"pointer,omitempty"` // the bunq.me alias, e.g. {Type: "URL", Value: "https://bunq.me/acme"}
+	Description          string   `json:"description,omitempty"`
+	Color                string   `json:"color,omitempty"` // hex, e.g. "#FF0000"
+	AttachmentPublicUUID string   `json:"attachment_public_uuid,omitempty"`
+	RedirectURL          string   `json:"redirect_url,omitempty"`
+}
+
+type BunqMeFundraiserService struct{ *service }
+
+// CreateProfile creates a fundraiser profile on a monetary account and
+// returns its ID.
+func (s *BunqMeFundraiserService) CreateProfile(ctx context.Context, monetaryAccountID int, params BunqMeFundraiserProfileCreateParams) (int, error) {
+	path := fmt.Sprintf("user/%d/monetary-account/%d/bunqme-fundraiser-profile", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
+	body, _, err := s.client.post(ctx, path, params)
+	if err != nil {
+		return 0, err
+	}
+	return unmarshalID(body)
+}
+
+// ListResults iterates over the fundraiser results of a monetary account,
+// each holding the payments received through a profile.
+func (s *BunqMeFundraiserService) ListResults(ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[BunqMeFundraiserResult, error] {
+	path := fmt.Sprintf("user/%d/monetary-account/%d/bunqme-fundraiser-result", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
+	return listIter[BunqMeFundraiserResult](s.client, ctx, path, "BunqMeFundraiserResult", opts)
+}
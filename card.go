@@ -0,0 +1,16 @@
+package bunq
+
+import "context"
+
+// AllowedNames returns the names that may be printed on a new card. bunq
+// rejects an order whose name_on_card is not one of them.
+func (s *CardService) AllowedNames(ctx context.Context) ([]string, error) {
+	var names []string
+	for n, err := range s.client.CardName.List(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, n.PossibleCardNameArray...)
+	}
+	return names, nil
+}
@@ -0,0 +1,131 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// The chat endpoints are not part of the Python SDK, so they are maintained
+// by hand here. A payment or request gets a chat conversation once created
+// with CreateForPayment or CreateForRequestInquiry; its messages are then
+// read and sent through the conversation ID.
+
+// Chat is the chat conversation attached to a payment or request.
+type Chat struct {
+	ID                 int    `json:"id,omitempty"`
+	Created            string `json:"created,omitempty"`
+	Updated            string `json:"updated,omitempty"`
+	UnreadMessageCount int    `json:"unread_message_count,omitempty"`
+}
+
+// ChatConversationMessage is one entry of a chat conversation. Exactly one
+// field is set, depending on whether a user wrote it, it reports a status
+// change, or bunq announced something.
+type ChatConversationMessage struct {
+	ChatMessageUser         *ChatMessageDetails `json:"ChatMessageUser,omitempty"`
+	ChatMessageStatus       *ChatMessageDetails `json:"ChatMessageStatus,omitempty"`
+	ChatMessageAnnouncement *ChatMessageDetails `json:"ChatMessageAnnouncement,omitempty"`
+}
+
+// Object returns the message, whichever kind it is, or nil if none is set.
+func (m *ChatConversationMessage) Object() any {
+	if d := m.Message(); d != nil {
+		return d
+	}
+	return nil
+}
+
+// Message returns the message, whichever kind it is, or nil if none is set.
+func (m *ChatConversationMessage) Message() *ChatMessageDetails {
+	switch {
+	case m.ChatMessageUser != nil:
+		return m.ChatMessageUser
+	case m.ChatMessageStatus != nil:
+		return m.ChatMessageStatus
+	default:
+		return m.ChatMessageAnnouncement
+	}
+}
+
+// ChatMessageDetails holds the fields common to all chat messages.
+type ChatMessageDetails struct {
+	ID              int                 `json:"id,omitempty"`
+	Created         string              `json:"created,omitempty"`
+	Updated         string              `json:"updated,omitempty"`
+	ConversationID  int                 `json:"conversation_id,omitempty"`
+	Creator         *LabelUser          `json:"creator,omitempty"`
+	DisplayedSender *LabelUser          `json:"displayed_sender,omitempty"`
+	Content         *ChatMessageContent `json:"content,omitempty"`
+}
+
+// Text returns the message text, or "" for messages without text.
+func (d *ChatMessageDetails) Text() string {
+	if d.Content == nil || d.Content.Text == nil {
+		return ""
+	}
+	return d.Content.Text.Text
+}
+
+// ChatMessageContent is the content of a chat message.
+type ChatMessageContent struct {
+	Text *ChatMessageContentText `json:"ChatMessageContentText,omitempty"`
+}
+
+// ChatMessageContentText is the content of a text message.
+type ChatMessageContentText struct {
+	Text string `json:"text,omitempty"`
+}
+
+type ChatService struct{ *service }
+
+// CreateForPayment opens the chat conversation of a payment and returns its
+// ID.
+func (s *ChatService) CreateForPayment(ctx context.Context, monetaryAccountID int, paymentID int) (int, error) {
+	path := fmt.Sprintf("user/%d/monetary-account/%d/payment/%d/chat", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentID)
+	return s.create(ctx, path)
+}
+
+// ListForPayment iterates over the chat conversations of a payment.
+func (s *ChatService) ListForPayment(ctx context.Context, monetaryAccountID int, paymentID int, opts *ListOptions) iter.Seq2[Chat, error] {
+	path := fmt.Sprintf("user/%d/monetary-account/%d/payment/%d/chat", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentID)
+	return listIter[Chat](s.client, ctx, path, "PaymentChat", opts)
+}
+
+// CreateForRequestInquiry opens the chat conversation of a request and
+// returns its ID.
+func (s *ChatService) CreateForRequestInquiry(ctx context.Context, monetaryAccountID int, requestInquiryID int) (int, error) {
+	path := fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry/%d/chat", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestInquiryID)
+	return s.create(ctx, path)
+}
+
+// ListForRequestInquiry iterates over the chat conversations of a request.
+func (s *ChatService) ListForRequestInquiry(ctx context.Context, monetaryAccountID int, requestInquiryID int, opts *ListOptions) iter.Seq2[Chat, error] {
+	path := fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry/%d/chat", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestInquiryID)
+	return listIter[Chat](s.client, ctx, path, "RequestInquiryChat", opts)
+}
+
+// SendText posts a text message to a chat conversation and returns its ID.
+func (s *ChatService) SendText(ctx context.Context, chatID int, text string) (int, error) {
+	path := fmt.Sprintf("user/%d/chat-conversation/%d/message-text", s.client.userID, chatID)
+	body, _, err := s.client.post(ctx, path, map[string]string{"text": text})
+	if err != nil {
+		return 0, err
+	}
+	return unmarshalID(body)
+}
+
+// ListMessages iterates over the messages of a chat conversation, newest
+// first.
+func (s *ChatService) ListMessages(ctx context.Context, chatID int, opts *ListOptions) iter.Seq2[ChatConversationMessage, error] {
+	path := fmt.Sprintf("user/%d/chat-conversation/%d/message", s.client.userID, chatID)
+	return listIter[ChatConversationMessage](s.client, ctx, path, "ChatMessage", opts)
+}
+
+func (s *ChatService) create(ctx context.Context, path string) (int, error) {
+	body, _, err := s.client.post(ctx, path, struct{}{})
+	if err != nil {
+		return 0, err
+	}
+	return unmarshalID(body)
+}
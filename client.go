@@ -5,11 +5,15 @@ import (
 	"context"
 	"crypto/rsa"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
+	"reflect"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -19,6 +23,18 @@ import (
 const userAgent = "bunq-go/1.0.0"
 
 // Client is the bunq API client. Create one with NewClient.
+//
+// A Client is safe for concurrent use by multiple goroutines. Once NewClient
+// returns, only this state changes, each part under its own mutex:
+//
+//   - sessionToken, sessionExpiry and sessionTimeout are guarded by mu and
+//     replaced transparently when the session is about to expire.
+//   - requests, the request times used for rate limit pacing, is guarded by
+//     requests.mu.
+//   - skew, the clock difference seen in bunq's Date headers, is guarded by
+//     skew.mu.
+//
+// Everything else, including userID, is fixed after bootstrap.
 type Client struct {
 	cfg        Config
 	httpClient *http.Client
@@ -28,18 +44,52 @@ type Client struct {
 	serverPublicKey *rsa.PublicKey
 
 	installationToken string
-	sessionToken      string
-	sessionExpiry     time.Time
+	sessionToken      string        // guarded by mu
+	sessionExpiry     time.Time     // guarded by mu
+	sessionTimeout    time.Duration // lifetime of the last session opened; guarded by mu
 
 	userID                   int
 	primaryMonetaryAccountID int
 
+	bootstrap BootstrapResult
+	requests  requestLog // guarded by requests.mu
+	skew      clockSkew  // guarded by skew.mu
+
+	// nowFunc and sleepFunc replace time.Now and sleepCtx for session
+	// expiry, retry backoff and polling, so tests can fake time. nil means
+	// real time.
+	nowFunc   func() time.Time
+	sleepFunc func(ctx context.Context, d time.Duration) error
+
 	mu sync.RWMutex
 
 	common service
 
 	// ServiceContainer embeds all generated service accessors (e.g. client.Payment, client.Card, etc.)
 	ServiceContainer
+
+	// Hand-written services for endpoints missing from the Python SDK.
+	CashRegister           *CashRegisterService
+	QR                     *QRService
+	MonetaryAccountProfile *MonetaryAccountProfileService
+	BunqMeFundraiser       *BunqMeFundraiserService
+	Chat                   *ChatService
+	TabResultInquiry       *TabResultInquiryService
+	TabResultResponse      *TabResultResponseService
+	ScheduledPaymentResult *ScheduledPaymentResultService
+}
+
+// initCustomServices wires up the hand-written services. It must be called
+// after initServices, which sets up the shared service.
+func (c *Client) initCustomServices() {
+	c.CashRegister = &CashRegisterService{&c.common}
+	c.QR = &QRService{&c.common}
+	c.MonetaryAccountProfile = &MonetaryAccountProfileService{&c.common}
+	c.BunqMeFundraiser = &BunqMeFundraiserService{&c.common}
+	c.Chat = &ChatService{&c.common}
+	c.TabResultInquiry = &TabResultInquiryService{&c.common}
+	c.TabResultResponse = &TabResultResponseService{&c.common}
+	c.ScheduledPaymentResult = &ScheduledPaymentResultService{&c.common}
 }
 
 type service struct {
@@ -54,8 +104,27 @@ func (c *Client) resolveMonetaryAccountID(id int) int {
 	return id
 }
 
+// defaultMaxResponseBytes is used when Config.MaxResponseBytes is zero.
+const defaultMaxResponseBytes = 32 << 20
+
 // request performs an authenticated HTTP request.
 func (c *Client) request(ctx context.Context, method, path string, body any, useSessionToken bool) ([]byte, http.Header, error) {
+	maxBytes := c.cfg.MaxResponseBytes
+	if maxBytes == 0 {
+		maxBytes = defaultMaxResponseBytes
+	}
+	return c.doRequest(ctx, method, path, body, useSessionToken, maxBytes)
+}
+
+// doRequest is request with an explicit response size limit; maxBytes < 0
+// means unlimited.
+func (c *Client) doRequest(ctx context.Context, method, path string, body any, useSessionToken bool, maxBytes int64) ([]byte, http.Header, error) {
+	if v, ok := body.(paramsValidator); ok {
+		if err := v.validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	if useSessionToken {
 		if err := c.ensureSessionActive(ctx); err != nil {
 			return nil, nil, err
@@ -84,30 +153,45 @@ func (c *Client) request(ctx context.Context, method, path string, body any, use
 	var bodyBytes []byte
 	if body != nil {
 		var err error
-		bodyBytes, err = json.Marshal(body)
+		bodyBytes, err = marshalBody(body)
 		if err != nil {
 			return nil, nil, fmt.Errorf("marshaling request body: %w", err)
 		}
 	}
 
+	// Encrypt once, so retries resend the same ciphertext. The signature
+	// covers the encrypted body, as sent.
+	var encHeaders http.Header
+	if c.cfg.EncryptRequests && useSessionToken && len(bodyBytes) > 0 && serverPubKey != nil {
+		var err error
+		bodyBytes, encHeaders, err = encryptRequest(serverPubKey, bodyBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	reqURL := c.baseURL + "/" + path
 
 	buildReq := func() (*http.Request, error) {
-		req, err := http.NewRequestWithContext(ctx, method, reqURL, bytes.NewReader(bodyBytes))
+		// Requests without a body, such as GET and DELETE, send none at all
+		// rather than an empty one.
+		var reqBody io.Reader
+		if len(bodyBytes) > 0 {
+			reqBody = bytes.NewReader(bodyBytes)
+		}
+		req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
 		if err != nil {
 			return nil, fmt.Errorf("creating request: %w", err)
 		}
-		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("User-Agent", userAgent)
-		req.Header.Set("X-Bunq-Client-Request-Id", uuid.New().String())
-		req.Header.Set("X-Bunq-Geolocation", "0 0 0 0 NL")
-		req.Header.Set("X-Bunq-Language", "en_US")
-		req.Header.Set("X-Bunq-Region", "nl_NL")
-		req.Header.Set("Cache-Control", "no-cache")
+		setDefaultHeaders(req)
+		for k, v := range encHeaders {
+			req.Header[k] = v
+		}
 		if token != "" {
 			req.Header.Set("X-Bunq-Client-Authentication", token)
 		}
 		if privateKey != nil && token != "" {
+			// bunq signs the body only; an empty body signs the empty string.
 			sig, err := signRequest(privateKey, bodyBytes)
 			if err != nil {
 				return nil, err
@@ -119,40 +203,50 @@ func (c *Client) request(ctx context.Context, method, path string, body any, use
 
 	var resp *http.Response
 	var respBody []byte
-	const maxRetries = 5
-	for attempt := range maxRetries + 1 {
+	for attempt := 0; ; attempt++ {
 		req, err := buildReq()
 		if err != nil {
 			return nil, nil, err
 		}
 
+		start := time.Now()
 		resp, err = c.httpClient.Do(req)
 		if err != nil {
-			return nil, nil, fmt.Errorf("executing request: %w", err)
-		}
-
-		respBody, err = io.ReadAll(resp.Body)
-		resp.Body.Close()
-		if err != nil {
-			return nil, nil, fmt.Errorf("reading response body: %w", err)
+			resp = nil
+			err = fmt.Errorf("executing request: %w", err)
+			c.requests.record(start, 0)
+			c.observe(ctx, method, path, attempt, 0, start, err)
+		} else {
+			c.requests.record(start, resp.StatusCode)
+			c.skew.record(resp.Header, c.now())
+			respBody, err = readBody(resp.Body, maxBytes)
+			resp.Body.Close()
+			if err != nil {
+				err = fmt.Errorf("reading response body: %w", err)
+				c.observe(ctx, method, path, attempt, resp.StatusCode, start, err)
+				return nil, nil, err
+			}
+			c.observe(ctx, method, path, attempt, resp.StatusCode, start, nil)
+			if resp.StatusCode == http.StatusOK {
+				break
+			}
 		}
 
-		if resp.StatusCode != http.StatusTooManyRequests || attempt == maxRetries {
-			break
+		retry, wait := c.retryDecision(attempt, resp, respBody, err, repeatable(ctx, method))
+		if retry && !takeRetry(ctx) {
+			if err == nil {
+				err = newAPIError(resp.StatusCode, resp.Header.Get("X-Bunq-Client-Response-Id"), respBody)
+			}
+			return nil, nil, fmt.Errorf("%w: %w", ErrRetryBudgetExhausted, err)
 		}
-
-		// bunq enforces a 30-second timeout after a 429. Use Retry-After
-		// header if present, otherwise exponential backoff: 1, 2, 4, 8, 16s.
-		wait := time.Second << attempt
-		if s := resp.Header.Get("Retry-After"); s != "" {
-			if secs, err := strconv.Atoi(s); err == nil && secs > 0 {
-				wait = time.Duration(secs) * time.Second
+		if !retry {
+			if err != nil {
+				return nil, nil, err
 			}
+			break
 		}
-		select {
-		case <-ctx.Done():
-			return nil, nil, ctx.Err()
-		case <-time.After(wait):
+		if err := c.sleep(ctx, wait); err != nil {
+			return nil, nil, err
 		}
 	}
 
@@ -174,6 +268,192 @@ func (c *Client) request(ctx context.Context, method, path string, body any, use
 	return respBody, resp.Header, nil
 }
 
+// paramsValidator is implemented by params that are checked client-side
+// before any request is made, such as PaymentCreateParams.
+type paramsValidator interface {
+	validate() error
+}
+
+// marshalBody encodes a request body. Fields tagged omitempty are left out
+// when zero, which for updates means "unchanged"; fields named in a params
+// struct's ForceSendFields are sent anyway, so they can be cleared. A forced
+// nil pointer field is sent as null.
+func marshalBody(body any) ([]byte, error) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(body))
+	if v.Kind() != reflect.Struct {
+		return b, nil
+	}
+	fv := v.FieldByName("ForceSendFields")
+	if !fv.IsValid() || fv.Len() == 0 {
+		return b, nil
+	}
+	force, _ := fv.Interface().([]string)
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	for _, name := range force {
+		sf, ok := v.Type().FieldByName(name)
+		tag, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if !ok || tag == "" || tag == "-" {
+			return nil, fmt.Errorf("ForceSendFields: %s has no field %q", v.Type().Name(), name)
+		}
+		if _, ok := fields[tag]; ok {
+			continue
+		}
+		raw, err := json.Marshal(v.FieldByIndex(sf.Index).Interface())
+		if err != nil {
+			return nil, err
+		}
+		fields[tag] = raw
+	}
+	return json.Marshal(fields)
+}
+
+// observe reports an HTTP attempt to the configured Observer, if any.
+func (c *Client) observe(ctx context.Context, method, path string, attempt, status int, start time.Time, err error) {
+	if c.cfg.Observer == nil {
+		return
+	}
+	c.cfg.Observer(RequestEvent{
+		Method:     method,
+		Path:       path,
+		Attempt:    attempt,
+		StatusCode: status,
+		Duration:   time.Since(start),
+		Err:        err,
+		Tag:        requestTag(ctx),
+	})
+}
+
+// now returns the current time of the client's clock.
+func (c *Client) now() time.Time {
+	if c.nowFunc != nil {
+		return c.nowFunc()
+	}
+	return time.Now()
+}
+
+// sleep waits for d on the client's clock, as sleepCtx does.
+func (c *Client) sleep(ctx context.Context, d time.Duration) error {
+	if c.sleepFunc != nil {
+		return c.sleepFunc(ctx, d)
+	}
+	return sleepCtx(ctx, d)
+}
+
+// sleepCtx waits for d, returning ctx.Err() early if ctx is done first. If
+// ctx's deadline falls before the wait would end, it fails immediately with
+// context.DeadlineExceeded instead of sleeping only to fail later.
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return context.DeadlineExceeded
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// setDefaultHeaders sets the headers bunq expects on every request. Set the
+// request body first: Content-Type is only sent along with a body.
+func setDefaultHeaders(req *http.Request) {
+	if req.ContentLength > 0 {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("X-Bunq-Client-Request-Id", requestID(req.Context()))
+	req.Header.Set("X-Bunq-Geolocation", geolocation(req.Context()))
+	req.Header.Set("X-Bunq-Language", "en_US")
+	req.Header.Set("X-Bunq-Region", "nl_NL")
+	req.Header.Set("Cache-Control", "no-cache")
+	if accept, ok := req.Context().Value(acceptKey{}).(string); ok {
+		req.Header.Set("Accept", accept)
+	}
+}
+
+// maxRetries is the number of times the built-in retry logic retries a request.
+const maxRetries = 5
+
+// retryDecision reports whether a failed attempt should be retried and how long
+// to wait before doing so. resp is nil when the request could not be executed.
+// Attempts that bunq may have processed are only retried if the request is
+// repeatable, whatever the RetryPolicy says.
+func (c *Client) retryDecision(attempt int, resp *http.Response, respBody []byte, err error, repeatable bool) (bool, time.Duration) {
+	if mayHaveSucceeded(resp) && !repeatable {
+		return false, 0
+	}
+	if c.cfg.RetryPolicy != nil {
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+			err = newAPIError(status, resp.Header.Get("X-Bunq-Client-Response-Id"), respBody)
+		}
+		return c.cfg.RetryPolicy(attempt, status, err)
+	}
+
+	if attempt >= maxRetries || !(isRateLimited(resp) || isTransient(resp, err)) {
+		return false, 0
+	}
+
+	// bunq enforces a 30-second timeout after a 429. Use Retry-After
+	// header if present, otherwise exponential backoff: 1, 2, 4, 8, 16s.
+	wait := time.Second << attempt
+	if resp == nil {
+		return true, min(wait, c.retryMaxDelay())
+	}
+	if s := resp.Header.Get("Retry-After"); s != "" {
+		if secs, err := strconv.Atoi(s); err == nil && secs > 0 {
+			wait = time.Duration(secs) * time.Second
+		}
+	}
+	return true, min(wait, c.retryMaxDelay())
+}
+
+// mayHaveSucceeded reports whether bunq may have processed a failed attempt:
+// the response never arrived, or bunq answered with a server error.
+func mayHaveSucceeded(resp *http.Response) bool {
+	return resp == nil || resp.StatusCode >= http.StatusInternalServerError
+}
+
+func isRateLimited(resp *http.Response) bool {
+	return resp != nil && resp.StatusCode == http.StatusTooManyRequests
+}
+
+// isTransient reports whether a failed attempt is likely to succeed when
+// retried: a network error, or bunq being briefly unavailable. Errors from a
+// canceled or expired context are final.
+func isTransient(resp *http.Response, err error) bool {
+	if resp == nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// defaultRetryMaxDelay is used when Config.RetryMaxDelay is zero.
+const defaultRetryMaxDelay = 30 * time.Second
+
+func (c *Client) retryMaxDelay() time.Duration {
+	if c.cfg.RetryMaxDelay > 0 {
+		return c.cfg.RetryMaxDelay
+	}
+	return defaultRetryMaxDelay
+}
+
 func (c *Client) get(ctx context.Context, path string, params map[string]string) ([]byte, http.Header, error) {
 	if len(params) > 0 {
 		v := make(url.Values, len(params))
@@ -185,6 +465,48 @@ func (c *Client) get(ctx context.Context, path string, params map[string]string)
 	return c.request(ctx, http.MethodGet, path, nil, true)
 }
 
+// download performs an authenticated GET for binary content, such as a PDF or
+// PNG, returning the raw body and its Content-Type.
+func (c *Client) download(ctx context.Context, path string) ([]byte, string, error) {
+	body, header, err := c.doRequest(ctx, http.MethodGet, path, nil, true, -1)
+	if err != nil {
+		return nil, "", err
+	}
+	return body, header.Get("Content-Type"), nil
+}
+
+type acceptKey struct{}
+
+// downloadAs is download for a file that must be of the given media type. It
+// asks for that type in the Accept header and fails with
+// ErrUnexpectedContentType if bunq returns another.
+func (c *Client) downloadAs(ctx context.Context, path, mediaType string) ([]byte, error) {
+	body, contentType, err := c.download(context.WithValue(ctx, acceptKey{}, mediaType), path)
+	if err != nil {
+		return nil, err
+	}
+	if got, _, err := mime.ParseMediaType(contentType); err != nil || got != mediaType {
+		return nil, fmt.Errorf("%w: got %q, want %q", ErrUnexpectedContentType, contentType, mediaType)
+	}
+	return body, nil
+}
+
+// readBody reads r to the end, failing with ErrResponseTooLarge once more
+// than maxBytes have been read. maxBytes < 0 means unlimited.
+func readBody(r io.Reader, maxBytes int64) ([]byte, error) {
+	if maxBytes < 0 {
+		return io.ReadAll(r)
+	}
+	b, err := io.ReadAll(io.LimitReader(r, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(b)) > maxBytes {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrResponseTooLarge, maxBytes)
+	}
+	return b, nil
+}
+
 func (c *Client) post(ctx context.Context, path string, body any) ([]byte, http.Header, error) {
 	return c.request(ctx, http.MethodPost, path, body, true)
 }
@@ -198,39 +520,59 @@ func (c *Client) delete(ctx context.Context, path string) error {
 	return err
 }
 
+// responseItems is the Response array of a bunq envelope. A few endpoints
+// return a single object instead of an array; it is decoded as an array
+// holding that object.
+type responseItems []json.RawMessage
+
+func (r *responseItems) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		*r = responseItems{json.RawMessage(bytes.Clone(data))}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]json.RawMessage)(r))
+}
+
 // unmarshalID extracts an ID from a bunq response: {"Response":[{"Id":{"id":N}}]}
 func unmarshalID(body []byte) (int, error) {
 	var envelope struct {
-		Response []json.RawMessage `json:"Response"`
+		Response responseItems `json:"Response"`
 	}
 	if err := json.Unmarshal(body, &envelope); err != nil {
 		return 0, fmt.Errorf("unmarshaling response envelope: %w", err)
 	}
+	if envelope.Response == nil {
+		return 0, fmt.Errorf("missing Response in envelope")
+	}
 	if len(envelope.Response) == 0 {
-		return 0, fmt.Errorf("empty response array")
+		return 0, ErrNoResult
 	}
 
 	var wrapper struct {
 		ID struct {
-			ID int `json:"id"`
+			ID FlexInt `json:"id"`
 		} `json:"Id"`
 	}
 	if err := json.Unmarshal(envelope.Response[0], &wrapper); err != nil {
 		return 0, fmt.Errorf("unmarshaling ID wrapper: %w", err)
 	}
-	return wrapper.ID.ID, nil
+	return int(wrapper.ID.ID), nil
 }
 
 // unmarshalUUID extracts a UUID from a bunq response: {"Response":[{"Uuid":{"uuid":"..."}}]}
 func unmarshalUUID(body []byte) (string, error) {
 	var envelope struct {
-		Response []json.RawMessage `json:"Response"`
+		Response responseItems `json:"Response"`
 	}
 	if err := json.Unmarshal(body, &envelope); err != nil {
 		return "", fmt.Errorf("unmarshaling response envelope: %w", err)
 	}
+	if envelope.Response == nil {
+		return "", fmt.Errorf("missing Response in envelope")
+	}
 	if len(envelope.Response) == 0 {
-		return "", fmt.Errorf("empty response array")
+		return "", ErrNoResult
 	}
 
 	var wrapper struct {
@@ -244,16 +586,50 @@ func unmarshalUUID(body []byte) (string, error) {
 	return wrapper.UUID.UUID, nil
 }
 
+// validateUUID checks that a UUID path parameter is well-formed, so it cannot
+// alter the request path.
+func validateUUID(name, value string) error {
+	if err := uuid.Validate(value); err != nil {
+		return fmt.Errorf("invalid %s %q: %w", name, value, err)
+	}
+	return nil
+}
+
+// anchorObject is implemented by generated anchor types such as
+// MonetaryAccount. bunq wraps their items in the concrete type's key, e.g.
+// {"MonetaryAccountBank": {...}}, so the decoders unmarshal the whole item into
+// the anchor, which has a field for each concrete type.
+type anchorObject interface {
+	Object() any
+}
+
+// isAnchor reports whether T is an anchor type.
+func isAnchor[T any]() bool {
+	_, ok := any(new(T)).(anchorObject)
+	return ok
+}
+
 // unmarshalObject extracts a single object from the response envelope.
 func unmarshalObject[T any](body []byte, key string) (*T, error) {
 	var envelope struct {
-		Response []json.RawMessage `json:"Response"`
+		Response responseItems `json:"Response"`
 	}
 	if err := json.Unmarshal(body, &envelope); err != nil {
 		return nil, fmt.Errorf("unmarshaling response envelope: %w", err)
 	}
+	if envelope.Response == nil {
+		return nil, fmt.Errorf("missing Response in envelope")
+	}
 	if len(envelope.Response) == 0 {
-		return nil, fmt.Errorf("empty response array")
+		return nil, ErrNoResult
+	}
+
+	var result T
+	if isAnchor[T]() {
+		if err := json.Unmarshal(envelope.Response[0], &result); err != nil {
+			return nil, fmt.Errorf("unmarshaling %s: %w", key, err)
+		}
+		return &result, nil
 	}
 
 	// Unwrap: {"Key": {...}}
@@ -267,25 +643,234 @@ func unmarshalObject[T any](body []byte, key string) (*T, error) {
 		return nil, fmt.Errorf("key %q not found in response", key)
 	}
 
-	var result T
 	if err := json.Unmarshal(inner, &result); err != nil {
 		return nil, fmt.Errorf("unmarshaling %s: %w", key, err)
 	}
 	return &result, nil
 }
 
+// UpdateResult is what bunq returned for an update. Depending on the
+// endpoint, that is the updated object or only its ID; ID is set either way.
+type UpdateResult[T any] struct {
+	ID     int
+	Object *T // nil if bunq returned only the ID
+}
+
+// unmarshalUpdate extracts the result of an update, accepting both an
+// {"Id":{"id":N}} item and the object under key, so the generated Update
+// methods need not know in advance which bunq sends.
+func unmarshalUpdate[T any](body []byte, key string) (*UpdateResult[T], error) {
+	var envelope struct {
+		Response responseItems `json:"Response"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("unmarshaling response envelope: %w", err)
+	}
+	if envelope.Response == nil {
+		return nil, fmt.Errorf("missing Response in envelope")
+	}
+	if len(envelope.Response) == 0 {
+		return nil, ErrNoResult
+	}
+
+	var result UpdateResult[T]
+	objectID := 0
+	for _, raw := range envelope.Response {
+		var outer map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &outer); err != nil {
+			return nil, fmt.Errorf("unmarshaling response item: %w", err)
+		}
+		if inner, ok := outer["Id"]; ok {
+			var wrapper struct {
+				ID FlexInt `json:"id"`
+			}
+			if err := json.Unmarshal(inner, &wrapper); err != nil {
+				return nil, fmt.Errorf("unmarshaling ID wrapper: %w", err)
+			}
+			result.ID = int(wrapper.ID)
+			continue
+		}
+
+		// An anchor decodes the whole item, {"<concrete type>": {...}};
+		// other types the value under key.
+		inner, ok := outer[key]
+		target := inner
+		if isAnchor[T]() {
+			for _, v := range outer {
+				inner = v
+			}
+			target, ok = raw, true
+		}
+		if !ok {
+			continue
+		}
+		var obj T
+		if err := json.Unmarshal(target, &obj); err != nil {
+			return nil, fmt.Errorf("unmarshaling %s: %w", key, err)
+		}
+		var idOnly struct {
+			ID FlexInt `json:"id"`
+		}
+		if err := json.Unmarshal(inner, &idOnly); err == nil {
+			objectID = int(idOnly.ID)
+		}
+		result.Object = &obj
+	}
+
+	if result.ID == 0 {
+		result.ID = objectID
+	}
+	if result.ID == 0 && result.Object == nil {
+		return nil, fmt.Errorf("neither Id nor %q found in response", key)
+	}
+	return &result, nil
+}
+
+// decodeList is a streaming variant of unmarshalList: it decodes the
+// Response array element by element and passes each item to yield as soon as
+// it is parsed. It stops without error when yield returns false, in which case
+// the returned Pagination may be nil. The body has already been read in full
+// (and its signature verified), so this saves the decoded items, not the raw
+// bytes.
+func decodeList[T any](body []byte, key string, yield func(T) bool) (*Pagination, error) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, fmt.Errorf("unmarshaling response envelope: %w", err)
+	}
+
+	var pagination *Pagination
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("unmarshaling response envelope: %w", err)
+		}
+		switch tok {
+		case "Response":
+			tok, err := dec.Token()
+			if err != nil {
+				return nil, fmt.Errorf("unmarshaling response envelope: %w", err)
+			}
+			if tok == nil {
+				continue // "Response": null
+			}
+			if tok == json.Delim('{') {
+				// A single object rather than an array; nothing to
+				// stream, so decode the whole body instead.
+				return decodeSingleItemList(body, key, yield)
+			}
+			if tok != json.Delim('[') {
+				return nil, fmt.Errorf("unmarshaling response envelope: expected [, got %v", tok)
+			}
+			for dec.More() {
+				item, ok, err := decodeListItem[T](dec, key)
+				if err != nil {
+					return nil, err
+				}
+				if ok && !yield(item) {
+					return pagination, nil
+				}
+			}
+			if err := expectDelim(dec, ']'); err != nil {
+				return nil, fmt.Errorf("unmarshaling response envelope: %w", err)
+			}
+		case "Pagination":
+			if err := dec.Decode(&pagination); err != nil {
+				return nil, fmt.Errorf("unmarshaling pagination: %w", err)
+			}
+		default:
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return nil, fmt.Errorf("unmarshaling response envelope: %w", err)
+			}
+		}
+	}
+	return pagination, nil
+}
+
+// decodeSingleItemList handles an envelope whose Response is an object, as
+// decodeList would have.
+func decodeSingleItemList[T any](body []byte, key string, yield func(T) bool) (*Pagination, error) {
+	resp, err := unmarshalList[T](body, key)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range resp.Items {
+		if !yield(item) {
+			break
+		}
+	}
+	return resp.Pagination, nil
+}
+
+// decodeListItem decodes one {"<key>": {...}} element of a Response array.
+// ok is false if the element holds a different key.
+func decodeListItem[T any](dec *json.Decoder, key string) (item T, ok bool, err error) {
+	if isAnchor[T]() {
+		if err := dec.Decode(&item); err != nil {
+			return item, false, fmt.Errorf("unmarshaling list item %s: %w", key, err)
+		}
+		return item, true, nil
+	}
+	if err := expectDelim(dec, '{'); err != nil {
+		return item, false, fmt.Errorf("unmarshaling list item: %w", err)
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return item, false, fmt.Errorf("unmarshaling list item: %w", err)
+		}
+		if tok == key {
+			if err := dec.Decode(&item); err != nil {
+				return item, false, fmt.Errorf("unmarshaling list item %s: %w", key, err)
+			}
+			ok = true
+			continue
+		}
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return item, false, fmt.Errorf("unmarshaling list item: %w", err)
+		}
+	}
+	if err := expectDelim(dec, '}'); err != nil {
+		return item, false, fmt.Errorf("unmarshaling list item: %w", err)
+	}
+	return item, ok, nil
+}
+
+// expectDelim reads the next token from dec and checks that it is want.
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != want {
+		return fmt.Errorf("expected %v, got %v", want, tok)
+	}
+	return nil
+}
+
 // unmarshalList extracts a list of objects from the response envelope.
 func unmarshalList[T any](body []byte, key string) (*listResponse[T], error) {
 	var envelope struct {
-		Response   []json.RawMessage `json:"Response"`
-		Pagination *Pagination       `json:"Pagination"`
+		Response   responseItems `json:"Response"`
+		Pagination *Pagination   `json:"Pagination"`
 	}
 	if err := json.Unmarshal(body, &envelope); err != nil {
 		return nil, fmt.Errorf("unmarshaling response envelope: %w", err)
 	}
 
+	anchor := isAnchor[T]()
 	items := make([]T, 0, len(envelope.Response))
 	for _, raw := range envelope.Response {
+		if anchor {
+			var item T
+			if err := json.Unmarshal(raw, &item); err != nil {
+				return nil, fmt.Errorf("unmarshaling list item %s: %w", key, err)
+			}
+			items = append(items, item)
+			continue
+		}
+
 		var outer map[string]json.RawMessage
 		if err := json.Unmarshal(raw, &outer); err != nil {
 			return nil, fmt.Errorf("unmarshaling list item: %w", err)
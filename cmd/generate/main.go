@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"regexp"
@@ -17,6 +18,8 @@ const (
 	outputObjectsFile   = "objects_gen.go"
 	outputEndpointsFile = "endpoints_gen.go"
 	outputServicesFile  = "services_gen.go"
+	outputExamplesFile  = "example_gen_test.go"
+	outputServicesJSON  = "services_gen.json"
 )
 
 // Parsed Python class information
@@ -65,10 +68,6 @@ type pyClass struct {
 	createReturnsID     bool
 	createReturnsUUID   bool
 	createReturnsObject bool
-
-	// Update return type
-	updateReturnsObject bool
-	updateReturnsID     bool
 }
 
 type pyField struct {
@@ -101,6 +100,7 @@ func main() {
 
 	// Build type registry for resolving references
 	typeRegistry := buildTypeRegistry(objectClasses, endpointClasses)
+	registerUUIDKeyedParams(endpointClasses)
 
 	// Post-process: resolve unknown types to any
 	for _, c := range objectClasses {
@@ -127,7 +127,9 @@ func main() {
 	// Generate files
 	generateObjectsFile(filteredObjects, typeRegistry)
 	generateEndpointsFile(endpointClasses, typeRegistry)
-	generateServicesFile(endpointClasses)
+	generateServicesFile(endpointClasses, filteredObjects)
+	generateExamplesFile(endpointClasses)
+	generateDescriptorFile(servicedClasses(endpointClasses))
 
 	fmt.Println("Code generation complete!")
 	fmt.Printf("  Objects: %d types\n", len(objectClasses))
@@ -256,6 +258,28 @@ func parseInitDocstring(body string) map[string]string {
 	return result
 }
 
+// extraResponseFields lists, by Go type name, response fields that bunq
+// sends but the Python SDK does not declare.
+var extraResponseFields = map[string][]pyField{
+	"Payment": {
+		// Pointer, so that an absent value is not mistaken for false.
+		{pythonName: "allow_chat", goName: "AllowChat", goType: "*bool", jsonTag: "allow_chat"},
+		{pythonName: "additional_transaction_information", goName: "AdditionalTransactionInformation", goType: "*AdditionalTransactionInformation", jsonTag: "additional_transaction_information"},
+	},
+	"MonetaryAccountBank":            {dailySpentField},
+	"MonetaryAccountCard":            {dailySpentField},
+	"MonetaryAccountExternal":        {dailySpentField},
+	"MonetaryAccountExternalSavings": {dailySpentField},
+	"MonetaryAccountInvestment":      {dailySpentField},
+	"MonetaryAccountJoint":           {dailySpentField},
+	"MonetaryAccountLight":           {dailySpentField},
+	"MonetaryAccountSavings":         {dailySpentField},
+}
+
+// dailySpentField is what a monetary account has spent today, counted
+// against its daily_limit.
+var dailySpentField = pyField{pythonName: "daily_spent", goName: "DailySpent", goType: "*Amount", jsonTag: "daily_spent"}
+
 func parseFields(body string, pc *pyClass) {
 	// Response fields: _field = None (but NOT _field_for_request)
 	responseRegex := regexp.MustCompile(`(?m)^\s+_(\w+)\s*=\s*None\s*$`)
@@ -268,6 +292,7 @@ func parseFields(body string, pc *pyClass) {
 		pyType := pc.docFields[fieldName]
 		goType := pythonTypeToGo(pyType, false)
 		goType = overrideIDFieldType(fieldName, goType)
+		goType = overrideNumericFieldType(fieldName, goType)
 		goFieldName := snakeToPascal(fieldName)
 		jsonTag := strings.TrimSuffix(strings.TrimPrefix(fieldName, "_"), "_")
 
@@ -279,6 +304,8 @@ func parseFields(body string, pc *pyClass) {
 		})
 	}
 
+	pc.responseFields = append(pc.responseFields, extraResponseFields[pc.goName]...)
+
 	// Request fields: _field_for_request = None
 	requestRegex := regexp.MustCompile(`(?m)^\s+_(\w+)_field_for_request\s*=\s*None\s*$`)
 	for _, match := range requestRegex.FindAllStringSubmatch(body, -1) {
@@ -411,11 +438,6 @@ func parseMethods(body string, pc *pyClass) {
 	}
 	if regexp.MustCompile(`def update\(cls`).MatchString(body) {
 		pc.hasUpdate = true
-		if strings.Contains(body, "_from_json(response_raw") && pc.objectTypePut != "" {
-			pc.updateReturnsObject = true
-		} else {
-			pc.updateReturnsID = true
-		}
 	}
 	if regexp.MustCompile(`def delete\(cls`).MatchString(body) {
 		pc.hasDelete = true
@@ -423,8 +445,17 @@ func parseMethods(body string, pc *pyClass) {
 }
 
 // buildTypeRegistry creates a set of known Go type names.
+// handWrittenTypes are types declared outside the generated files that
+// extraResponseFields refer to.
+var handWrittenTypes = []string{
+	"AdditionalTransactionInformation", // payments.go
+}
+
 func buildTypeRegistry(objectClasses, endpointClasses []*pyClass) map[string]bool {
 	reg := map[string]bool{}
+	for _, name := range handWrittenTypes {
+		reg[name] = true
+	}
 	for _, c := range objectClasses {
 		reg[c.goName] = true
 	}
@@ -436,10 +467,35 @@ func buildTypeRegistry(objectClasses, endpointClasses []*pyClass) map[string]boo
 
 // resolveTypes replaces unknown pointer types with any.
 func resolveTypes(pc *pyClass, registry map[string]bool) {
+	if pc.isAnchor {
+		resolveAnchorFields(pc, registry)
+	}
 	resolveFieldTypes(pc.responseFields, registry)
 	resolveFieldTypes(pc.requestFields, registry)
 }
 
+// resolveAnchorFields types the variant fields of an anchor object. Each is
+// named after the concrete type it holds, e.g. MonetaryAccount.MonetaryAccountBank,
+// but the docstrings often type them as str.
+func resolveAnchorFields(pc *pyClass, registry map[string]bool) {
+	for i, f := range pc.responseFields {
+		if !isAnchorVariant(f) {
+			continue
+		}
+		if registry[f.jsonTag] {
+			pc.responseFields[i].goType = "*" + f.jsonTag
+		} else {
+			pc.responseFields[i].goType = "any"
+		}
+	}
+}
+
+// isAnchorVariant reports whether f is one of an anchor object's variant
+// fields, whose JSON key is a type name rather than a snake_case field.
+func isAnchorVariant(f pyField) bool {
+	return f.jsonTag != "" && unicode.IsUpper(rune(f.jsonTag[0]))
+}
+
 func resolveFieldTypes(fields []pyField, registry map[string]bool) {
 	for i := range fields {
 		fields[i].goType = resolveType(fields[i].goType, registry)
@@ -529,6 +585,11 @@ func pythonTypeToGo(pyType string, isRequest bool) string {
 		return "*Geolocation"
 	case "Attachment":
 		return "*Attachment"
+	case "NotificationFilterEmail", "NotificationFilterPush", "NotificationFilterUrl":
+		// The per-filter objects share their names with the endpoints that
+		// hold them, which take precedence. They are subsets of
+		// NotificationFilter, which serializes the same way.
+		return "*NotificationFilter"
 	case "MonetaryAccountReference":
 		// MonetaryAccountReference is a Python-side wrapper; the API
 		// serializes it as LabelMonetaryAccount.
@@ -548,14 +609,45 @@ func pythonTypeToGo(pyType string, isRequest bool) string {
 }
 
 // overrideIDFieldType fixes Python SDK type annotations that incorrectly
-// declare _id fields as str. The bunq API always returns IDs as integers.
+// declare _id fields as str, or leave them out so pythonTypeToGo falls back
+// to string. The bunq API always returns IDs as integers; UUIDs are named
+// _uuid and keep their string type.
 func overrideIDFieldType(fieldName, goType string) string {
-	if strings.HasSuffix(fieldName, "_id") && goType == "string" {
+	name := strings.Trim(fieldName, "_")
+	if goType == "string" && (name == "id" || strings.HasSuffix(name, "_id")) {
 		return "int"
 	}
 	return goType
 }
 
+// numericFieldWords mark response fields that hold a number even though the
+// Python SDK declares them as str; bunq sends these as either JSON numbers or
+// numeric strings, e.g. "rate": "1.0852".
+var numericFieldWords = map[string]bool{
+	"amount": true, "progress": true, "rate": true, "fraction": true, "percentage": true,
+}
+
+// overrideNumericFieldType maps string response fields such as rate,
+// rate_applied or savings_goal_progress to FlexFloat64, which decodes both
+// forms. Enum-like fields such as amount_type are left alone. It is not
+// applied to request fields, which must be sent in the form bunq expects.
+func overrideNumericFieldType(fieldName, goType string) string {
+	if goType != "string" {
+		return goType
+	}
+	parts := strings.Split(strings.Trim(fieldName, "_"), "_")
+	switch parts[len(parts)-1] {
+	case "type", "status", "description", "currency":
+		return goType
+	}
+	for _, p := range parts {
+		if numericFieldWords[p] {
+			return "FlexFloat64"
+		}
+	}
+	return goType
+}
+
 // snakeToPascal converts snake_case to PascalCase.
 func snakeToPascal(s string) string {
 	// Handle trailing underscore (Python reserved word escaping)
@@ -628,6 +720,23 @@ type urlParam struct {
 	name   string
 	goName string
 	goType string
+	isUUID bool // keyed by UUID rather than numeric ID; goType is "string"
+}
+
+// uuidKeyedParams holds the URL parameter names of endpoints whose objects
+// are keyed by UUID, e.g. "attachment_public". See registerUUIDKeyedParams.
+var uuidKeyedParams = map[string]bool{}
+
+// registerUUIDKeyedParams records every endpoint whose Create returns a UUID
+// as UUID-keyed, so URL parameters that refer to it become validated strings.
+func registerUUIDKeyedParams(classes []*pyClass) {
+	for _, pc := range classes {
+		if !pc.createReturnsUUID || pc.urlCreate == "" {
+			continue
+		}
+		parts := strings.Split(pc.urlCreate, "/")
+		uuidKeyedParams[urlSegmentToParamName(parts[len(parts)-1])] = true
+	}
 }
 
 // analyzeURL parses a bunq URL pattern and returns the Go format string and parameters.
@@ -645,7 +754,7 @@ func analyzeURL(urlPattern string, pc *pyClass) (fmtStr string, params []urlPara
 	paramIdx := 0
 	for _, part := range parts {
 		if part == "{}" {
-			if paramIdx < len(params) && params[paramIdx].goType == "string" {
+			if paramIdx < len(params) && params[paramIdx].isUUID {
 				fmtParts = append(fmtParts, "%s")
 			} else {
 				fmtParts = append(fmtParts, "%d")
@@ -676,19 +785,16 @@ func resolveURLParams(urlPattern string) []urlParam {
 			paramName = urlSegmentToParamName(preceding)
 		}
 
-		goName := snakeToPascal(paramName)
-		goType := "int"
-
-		// Special case: attachment-public uses UUID (string)
-		if paramName == "attachment_public" {
-			goType = "string"
-		}
-
-		params = append(params, urlParam{
+		p := urlParam{
 			name:   paramName,
-			goName: goName,
-			goType: goType,
-		})
+			goName: snakeToPascal(paramName),
+			goType: "int",
+		}
+		if uuidKeyedParams[paramName] {
+			p.isUUID = true
+			p.goType = "string"
+		}
+		params = append(params, p)
 	}
 
 	return params
@@ -711,6 +817,7 @@ func generateObjectsFile(classes []*pyClass, typeRegistry map[string]bool) {
 	for _, pc := range classes {
 		writeStruct(&b, pc, typeRegistry, false)
 		b.WriteString("\n")
+		writeAnchorObjectMethod(&b, pc)
 	}
 
 	if err := os.WriteFile(outputObjectsFile, []byte(b.String()), 0644); err != nil {
@@ -729,6 +836,7 @@ func generateEndpointsFile(classes []*pyClass, typeRegistry map[string]bool) {
 		// Write main response struct
 		writeStruct(&b, pc, typeRegistry, false)
 		b.WriteString("\n")
+		writeAnchorObjectMethod(&b, pc)
 
 		// Write create params if has create method with request fields
 		if pc.hasCreate && len(pc.requestFields) > 0 {
@@ -774,6 +882,35 @@ func writeStruct(b *strings.Builder, pc *pyClass, typeRegistry map[string]bool,
 	b.WriteString("}\n")
 }
 
+// writeAnchorObjectMethod emits Object for anchor objects, which returns
+// whichever variant field is set so callers can type-switch on it. Together
+// with the decoders treating anchors as a whole (see anchorObject), this
+// dispatches {"MonetaryAccountBank": {...}} to *MonetaryAccountBank.
+func writeAnchorObjectMethod(b *strings.Builder, pc *pyClass) {
+	if !pc.isAnchor {
+		return
+	}
+	var variants []string
+	seen := map[string]bool{}
+	for _, f := range pc.responseFields {
+		if isAnchorVariant(f) && !seen[f.goName] {
+			seen[f.goName] = true
+			variants = append(variants, f.goName)
+		}
+	}
+	if len(variants) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "// Object returns the concrete object held by the %s, or nil if none is set.\n", pc.goName)
+	fmt.Fprintf(b, "func (a *%s) Object() any {\n", pc.goName)
+	b.WriteString("\tswitch {\n")
+	for _, v := range variants {
+		fmt.Fprintf(b, "\tcase a.%s != nil:\n\t\treturn a.%s\n", v, v)
+	}
+	b.WriteString("\t}\n\treturn nil\n}\n\n")
+}
+
 func writeParamsStruct(b *strings.Builder, pc *pyClass, action string, typeRegistry map[string]bool) {
 	structName := pc.goName + action + "Params"
 
@@ -793,23 +930,24 @@ func writeParamsStruct(b *strings.Builder, pc *pyClass, action string, typeRegis
 		fmt.Fprintf(b, "\t%s %s `json:\"%s,omitempty\"`\n", f.goName, f.goType, f.jsonTag)
 	}
 
+	// omitempty cannot tell "leave unchanged" from "set to the zero value",
+	// so updates can name fields that must be sent regardless.
+	if action == "Update" {
+		b.WriteString("\t// ForceSendFields lists fields, by Go name, to send even when zero.\n")
+		b.WriteString("\tForceSendFields []string `json:\"-\"`\n")
+	}
+
 	b.WriteString("}\n")
 }
 
-func generateServicesFile(classes []*pyClass) {
+func generateServicesFile(classes, objectClasses []*pyClass) {
 	var b strings.Builder
 
 	b.WriteString("// Code generated by cmd/generate; DO NOT EDIT.\n\n")
 	b.WriteString("package bunq\n\n")
 	b.WriteString("import (\n\t\"context\"\n\t\"fmt\"\n\t\"iter\"\n)\n\n")
 
-	// Collect service types with methods
-	var serviceClasses []*pyClass
-	for _, pc := range classes {
-		if pc.hasCreate || pc.hasGet || pc.hasList || pc.hasUpdate || pc.hasDelete {
-			serviceClasses = append(serviceClasses, pc)
-		}
-	}
+	serviceClasses := servicedClasses(classes)
 
 	// Generate service types
 	for _, pc := range serviceClasses {
@@ -837,12 +975,365 @@ func generateServicesFile(classes []*pyClass) {
 	}
 	b.WriteString("}\n")
 
+	generateEndpointRegistry(&b, serviceClasses)
+	generateListRegistry(&b, serviceClasses)
+	generateReferenceFetchers(&b, append(slices.Clone(objectClasses), classes...), serviceClasses)
+
 	if err := os.WriteFile(outputServicesFile, []byte(b.String()), 0644); err != nil {
 		fatal("writing %s: %v", outputServicesFile, err)
 	}
 	fmt.Printf("Generated %s\n", outputServicesFile)
 }
 
+// servicedClasses returns the classes that get a service, i.e. that have at
+// least one operation.
+func servicedClasses(classes []*pyClass) []*pyClass {
+	var serviced []*pyClass
+	for _, pc := range classes {
+		if pc.hasCreate || pc.hasGet || pc.hasList || pc.hasUpdate || pc.hasDelete {
+			serviced = append(serviced, pc)
+		}
+	}
+	return serviced
+}
+
+// generateExamplesFile emits an Example for every generated Create method.
+// They are not run, but compiling them keeps the documented calls in sync
+// with the generated API. The params set the fields the Python SDK requires
+// to placeholder values. exampleClient and ptr live in example_test.go.
+func generateExamplesFile(classes []*pyClass) {
+	var b strings.Builder
+
+	b.WriteString("// Code generated by cmd/generate; DO NOT EDIT.\n\n")
+	b.WriteString("package bunq_test\n\n")
+	b.WriteString("import (\n\t\"context\"\n\t\"fmt\"\n\t\"log\"\n\n\tbunq \"github.com/gwillem/bunq-go\"\n)\n")
+
+	for _, pc := range classes {
+		if !pc.hasCreate || pc.urlCreate == "" {
+			continue
+		}
+
+		_, urlParams := analyzeURL(pc.urlCreate, pc)
+		args := []string{"context.Background()"}
+		for _, rp := range resolveURLParamNames(urlParams) {
+			switch {
+			case rp.isImplicit:
+			case rp.paramDecl == "monetaryAccountID int":
+				args = append(args, "0") // primary account
+			case strings.HasSuffix(rp.paramDecl, " string"):
+				args = append(args, `"9f4c6a2e-3b1d-4e8a-9c7f-5d2b1a0e6f3c"`) // UUID
+			default:
+				args = append(args, "1")
+			}
+		}
+		if len(pc.requestFields) > 0 {
+			args = append(args, exampleParams(pc))
+		}
+
+		fmt.Fprintf(&b, "\nfunc Example%sService_Create() {\n", pc.goName)
+		b.WriteString("\tclient := exampleClient()\n")
+		fmt.Fprintf(&b, "\tresult, err := client.%s.Create(%s)\n", pc.goName, strings.Join(args, ", "))
+		b.WriteString("\tif err != nil {\n\t\tlog.Fatal(err)\n\t}\n")
+		b.WriteString("\tfmt.Println(result)\n")
+		b.WriteString("}\n")
+	}
+
+	if err := os.WriteFile(outputExamplesFile, []byte(b.String()), 0644); err != nil {
+		fatal("writing %s: %v", outputExamplesFile, err)
+	}
+	fmt.Printf("Generated %s\n", outputExamplesFile)
+}
+
+// exampleParams returns a CreateParams literal for pc's example. The fields
+// the Python SDK requires, the __init__ parameters without a default, are set
+// to placeholder values.
+func exampleParams(pc *pyClass) string {
+	required := map[string]bool{}
+	for _, p := range pc.initParams {
+		if !p.hasDefault {
+			required[p.pythonName] = true
+		}
+	}
+	var fields []string
+	for _, f := range pc.requestFields {
+		if required[f.pythonName] {
+			fields = append(fields, fmt.Sprintf("%s: %s,", f.goName, placeholderValue(f)))
+		}
+	}
+	if len(fields) == 0 {
+		return fmt.Sprintf("bunq.%sCreateParams{}", pc.goName)
+	}
+	return fmt.Sprintf("bunq.%sCreateParams{\n\t\t%s\n\t}", pc.goName, strings.Join(fields, "\n\t\t"))
+}
+
+// placeholderValue returns an example value of f's type, as Go source in
+// package bunq_test.
+func placeholderValue(f pyField) string {
+	switch f.goType {
+	case "string":
+		if f.jsonTag == "currency" {
+			return `"EUR"`
+		}
+		return `"example"`
+	case "int":
+		return "1"
+	case "FlexFloat64":
+		return "bunq.FlexFloat64(1)"
+	case "bool":
+		return "true"
+	case "any":
+		return "nil"
+	case "*Amount":
+		return `bunq.NewAmount(1, "EUR")`
+	case "*Pointer":
+		return `&bunq.Pointer{Type: "EMAIL", Value: "bravo@bunq.com"}`
+	}
+	if elem, ok := strings.CutPrefix(f.goType, "*"); ok && primitiveTypes[elem] {
+		return "ptr(" + placeholderValue(pyField{goType: elem, jsonTag: f.jsonTag}) + ")"
+	}
+	if elem, ok := strings.CutPrefix(f.goType, "*"); ok {
+		return "&" + exampleType(elem) + "{}"
+	}
+	return exampleType(f.goType) + "{}"
+}
+
+// exampleType qualifies the named type in goType with the bunq package, e.g.
+// "[]*Payment" becomes "[]*bunq.Payment".
+func exampleType(goType string) string {
+	name := strings.TrimLeft(goType, "[]*")
+	prefix := goType[:len(goType)-len(name)]
+	if name == "" || unicode.IsLower(rune(name[0])) {
+		return goType
+	}
+	return prefix + "bunq." + name
+}
+
+// serviceDescriptor describes a generated service in services_gen.json, for
+// tools that work from the API surface rather than the Go source, such as
+// documentation and wrappers in other languages.
+type serviceDescriptor struct {
+	Name    string             `json:"name"` // e.g. "PaymentService"
+	Type    string             `json:"type"` // e.g. "Payment"
+	Methods []methodDescriptor `json:"methods"`
+}
+
+// methodDescriptor describes one service method. CreateAndFetch is left out:
+// it makes no request of its own.
+type methodDescriptor struct {
+	Name       string            `json:"name"`
+	HTTPMethod string            `json:"http_method"`
+	Path       string            `json:"path"` // URL template, "{}" per path parameter
+	Params     []paramDescriptor `json:"params"`
+	Body       string            `json:"body,omitempty"`      // params struct, if any
+	Returns    string            `json:"returns,omitempty"`   // Go type besides error, if any
+	Paginated  bool              `json:"paginated,omitempty"` // Returns is the element type of a paginated list
+}
+
+// paramDescriptor is a method parameter taken from the URL. The user ID is
+// implicit and not listed.
+type paramDescriptor struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// generateDescriptorFile emits services_gen.json, a machine-readable
+// description of the methods in services_gen.go.
+func generateDescriptorFile(serviceClasses []*pyClass) {
+	services := make([]serviceDescriptor, len(serviceClasses))
+	for i, pc := range serviceClasses {
+		services[i] = describeService(pc)
+	}
+
+	data, err := json.MarshalIndent(services, "", "  ")
+	if err != nil {
+		fatal("encoding %s: %v", outputServicesJSON, err)
+	}
+	if err := os.WriteFile(outputServicesJSON, append(data, '\n'), 0644); err != nil {
+		fatal("writing %s: %v", outputServicesJSON, err)
+	}
+	fmt.Printf("Generated %s\n", outputServicesJSON)
+}
+
+// describeService describes the methods generateServiceMethods emits for pc,
+// in the same order.
+func describeService(pc *pyClass) serviceDescriptor {
+	sd := serviceDescriptor{
+		Name:    pc.goName + "Service",
+		Type:    pc.goName,
+		Methods: []methodDescriptor{},
+	}
+	hasParams := len(pc.requestFields) > 0
+
+	add := func(name, httpMethod, url, body, returns string) *methodDescriptor {
+		_, urlParams := analyzeURL(url, pc)
+		md := methodDescriptor{
+			Name:       name,
+			HTTPMethod: httpMethod,
+			Path:       url,
+			Params:     []paramDescriptor{},
+			Body:       body,
+			Returns:    returns,
+		}
+		for _, rp := range resolveURLParamNames(urlParams) {
+			if rp.paramDecl != "" {
+				pname, ptype, _ := strings.Cut(rp.paramDecl, " ")
+				md.Params = append(md.Params, paramDescriptor{Name: pname, Type: ptype})
+			}
+		}
+		sd.Methods = append(sd.Methods, md)
+		return &sd.Methods[len(sd.Methods)-1]
+	}
+
+	if pc.hasCreate && pc.urlCreate != "" {
+		body := ""
+		if hasParams {
+			body = pc.goName + "CreateParams"
+		}
+		returns := "int"
+		switch {
+		case pc.createReturnsUUID:
+			returns = "string"
+		case pc.createReturnsObject:
+			returns = "*" + pc.goName
+		}
+		add("Create", "POST", pc.urlCreate, body, returns)
+	}
+	if pc.hasGet && pc.urlRead != "" {
+		add("Get", "GET", pc.urlRead, "", "*"+pc.goName)
+	}
+	if pc.hasList && pc.urlListing != "" {
+		add("List", "GET", pc.urlListing, "", pc.goName).Paginated = true
+	}
+	if pc.hasUpdate && pc.urlUpdate != "" {
+		body := ""
+		if hasParams {
+			body = pc.goName + "UpdateParams"
+		}
+		add("Update", "PUT", pc.urlUpdate, body, "*UpdateResult["+pc.goName+"]")
+	}
+	if pc.hasDelete && pc.urlDelete != "" {
+		add("Delete", "DELETE", pc.urlDelete, "", "")
+	}
+	return sd
+}
+
+// generateEndpointRegistry emits the map behind EndpointInfo, listing the URL
+// template of every operation that has a generated service method.
+func generateEndpointRegistry(b *strings.Builder, classes []*pyClass) {
+	b.WriteString("\nvar endpointRegistry = map[string]EndpointMeta{\n")
+	for _, pc := range classes {
+		var fields []string
+		for _, op := range []struct {
+			name string
+			has  bool
+			url  string
+		}{
+			{"Create", pc.hasCreate, pc.urlCreate},
+			{"Read", pc.hasGet, pc.urlRead},
+			{"List", pc.hasList, pc.urlListing},
+			{"Update", pc.hasUpdate, pc.urlUpdate},
+			{"Delete", pc.hasDelete, pc.urlDelete},
+		} {
+			if op.has && op.url != "" {
+				fields = append(fields, fmt.Sprintf("%s: %q", op.name, op.url))
+			}
+		}
+		fmt.Fprintf(b, "\t%q: {%s},\n", pc.goName, strings.Join(fields, ", "))
+	}
+	b.WriteString("}\n")
+}
+
+// generateListRegistry emits the map behind Client.ListAll, with a listFunc
+// for every List method that needs no path parameter other than the
+// monetary account ID.
+func generateListRegistry(b *strings.Builder, classes []*pyClass) {
+	b.WriteString("\nvar listRegistry = map[string]listFunc{\n")
+	for _, pc := range classes {
+		if !pc.hasList || pc.urlListing == "" {
+			continue
+		}
+		_, urlParams := analyzeURL(pc.urlListing, pc)
+		args := "ctx"
+		listable := true
+		for _, rp := range resolveURLParamNames(urlParams) {
+			switch {
+			case rp.isImplicit:
+			case rp.paramDecl == "monetaryAccountID int":
+				args += ", monetaryAccountID"
+			default:
+				listable = false
+			}
+		}
+		if !listable {
+			continue
+		}
+		fmt.Fprintf(b, "\t%q: func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {\n", pc.goName)
+		fmt.Fprintf(b, "\t\treturn anySeq(c.%s.List(%s, opts))\n", pc.goName, args)
+		b.WriteString("\t},\n")
+	}
+	b.WriteString("}\n")
+}
+
+// generateReferenceFetchers emits Fetch<Name> methods on response types for
+// integer <name>_id fields that refer to a type with a generated Get method,
+// e.g. Payment.FetchMonetaryAccount for monetary_account_id. Gets that need a
+// monetary account besides the ID are only used when the referencing type
+// carries its own monetary_account_id.
+func generateReferenceFetchers(b *strings.Builder, classes, serviceClasses []*pyClass) {
+	getters := map[string]*pyClass{}
+	for _, pc := range serviceClasses {
+		if pc.hasGet && pc.urlRead != "" {
+			getters[pc.goName] = pc
+		}
+	}
+
+	for _, pc := range classes {
+		hasMonetaryAccountID := false
+		for _, f := range pc.responseFields {
+			if f.goName == "MonetaryAccountID" && f.goType == "int" {
+				hasMonetaryAccountID = true
+			}
+		}
+
+		seen := map[string]bool{}
+		for _, f := range pc.responseFields {
+			if seen[f.goName] || f.goType != "int" || !strings.HasSuffix(f.jsonTag, "_id") {
+				continue
+			}
+			seen[f.goName] = true
+
+			target, ok := getters[strings.TrimSuffix(f.goName, "ID")]
+			if !ok {
+				continue
+			}
+			_, readParams := analyzeURL(target.urlRead, target)
+			var decls []string
+			for _, rp := range resolveURLParamNames(readParams) {
+				if rp.paramDecl != "" {
+					decls = append(decls, rp.paramDecl)
+				}
+			}
+
+			var args string
+			switch {
+			case len(decls) == 1:
+				args = "o." + f.goName
+			case len(decls) == 2 && decls[0] == "monetaryAccountID int" && hasMonetaryAccountID && f.goName != "MonetaryAccountID":
+				args = "o.MonetaryAccountID, o." + f.goName
+			default:
+				continue
+			}
+
+			fmt.Fprintf(b, "\nfunc (o *%s) Fetch%s(ctx context.Context, c *Client) (*%s, error) {\n",
+				pc.goName, target.goName, target.goName)
+			fmt.Fprintf(b, "\tif o.%s == 0 {\n\t\treturn nil, fmt.Errorf(\"no %s set on %s\")\n\t}\n",
+				f.goName, f.jsonTag, pc.goName)
+			fmt.Fprintf(b, "\treturn c.%s.Get(ctx, %s)\n", target.goName, args)
+			b.WriteString("}\n")
+		}
+	}
+}
+
 func generateServiceMethods(b *strings.Builder, pc *pyClass) {
 	serviceName := pc.goName + "Service"
 
@@ -852,6 +1343,9 @@ func generateServiceMethods(b *strings.Builder, pc *pyClass) {
 	if pc.hasGet {
 		generateGetMethod(b, pc, serviceName)
 	}
+	if pc.hasCreate && pc.hasGet {
+		generateCreateAndFetchMethod(b, pc, serviceName)
+	}
 	if pc.hasList {
 		generateListMethod(b, pc, serviceName)
 	}
@@ -901,7 +1395,7 @@ func generateCreateMethod(b *strings.Builder, pc *pyClass, serviceName string) {
 		serviceName, methodParams.signature, paramsArg, returnType)
 
 	// Build path
-	writePathConstruction(b, fmtStr, urlParams, pc)
+	writePathConstruction(b, fmtStr, urlParams, pc, zeroErrReturn(returnType))
 
 	// HTTP call
 	if hasParams {
@@ -931,7 +1425,7 @@ func generateGetMethod(b *strings.Builder, pc *pyClass, serviceName string) {
 	fmt.Fprintf(b, "func (s *%s) Get(ctx context.Context%s) (*%s, error) {\n",
 		serviceName, methodParams.signature, pc.goName)
 
-	writePathConstruction(b, fmtStr, urlParams, pc)
+	writePathConstruction(b, fmtStr, urlParams, pc, "return nil, err")
 
 	b.WriteString("\tbody, _, err := s.client.get(ctx, path, nil)\n")
 	b.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n")
@@ -939,6 +1433,53 @@ func generateGetMethod(b *strings.Builder, pc *pyClass, serviceName string) {
 	b.WriteString("}\n\n")
 }
 
+// generateCreateAndFetchMethod emits CreateAndFetch for endpoints whose Create
+// returns only an ID and whose read URL is the create URL plus that ID. An
+// idempotency key on the context applies to the Create only.
+func generateCreateAndFetchMethod(b *strings.Builder, pc *pyClass, serviceName string) {
+	if pc.createReturnsUUID || pc.createReturnsObject || pc.urlCreate == "" || pc.urlRead == "" {
+		return
+	}
+
+	_, createParams := analyzeURL(pc.urlCreate, pc)
+	_, readParams := analyzeURL(pc.urlRead, pc)
+	if len(readParams) != len(createParams)+1 || readParams[len(readParams)-1].goType != "int" {
+		return
+	}
+	for i, p := range createParams {
+		if readParams[i].name != p.name {
+			return
+		}
+	}
+
+	methodParams := buildMethodParams(createParams, pc, true)
+
+	var args []string
+	for _, rp := range resolveURLParamNames(createParams) {
+		if rp.paramDecl != "" {
+			args = append(args, strings.Fields(rp.paramDecl)[0])
+		}
+	}
+	argList := ""
+	if len(args) > 0 {
+		argList = ", " + strings.Join(args, ", ")
+	}
+
+	hasParams := len(pc.requestFields) > 0
+	paramsArg, paramsCall := "", ""
+	if hasParams {
+		paramsArg = fmt.Sprintf(", params %sCreateParams", pc.goName)
+		paramsCall = ", params"
+	}
+
+	fmt.Fprintf(b, "func (s *%s) CreateAndFetch(ctx context.Context%s%s) (*%s, error) {\n",
+		serviceName, methodParams.signature, paramsArg, pc.goName)
+	fmt.Fprintf(b, "\tid, err := s.Create(ctx%s%s)\n", argList, paramsCall)
+	b.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+	fmt.Fprintf(b, "\treturn s.Get(withoutIdempotencyKey(ctx)%s, id)\n", argList)
+	b.WriteString("}\n\n")
+}
+
 func generateListMethod(b *strings.Builder, pc *pyClass, serviceName string) {
 	url := pc.urlListing
 	if url == "" {
@@ -956,7 +1497,7 @@ func generateListMethod(b *strings.Builder, pc *pyClass, serviceName string) {
 	fmt.Fprintf(b, "func (s *%s) List(ctx context.Context%s, opts *ListOptions) iter.Seq2[%s, error] {\n",
 		serviceName, methodParams.signature, pc.goName)
 
-	writePathConstruction(b, fmtStr, urlParams, pc)
+	writePathConstruction(b, fmtStr, urlParams, pc, fmt.Sprintf("return errIter[%s](err)", pc.goName))
 
 	fmt.Fprintf(b, "\treturn listIter[%s](s.client, ctx, path, %q, opts)\n", pc.goName, key)
 	b.WriteString("}\n\n")
@@ -977,37 +1518,24 @@ func generateUpdateMethod(b *strings.Builder, pc *pyClass, serviceName string) {
 		paramsArg = fmt.Sprintf(", params %sUpdateParams", pc.goName)
 	}
 
-	if pc.updateReturnsObject {
-		key := pc.objectTypePut
-		if key == "" {
-			key = pc.goName
-		}
-		fmt.Fprintf(b, "func (s *%s) Update(ctx context.Context%s%s) (*%s, error) {\n",
-			serviceName, methodParams.signature, paramsArg, pc.goName)
+	// The Python SDK's update either parses an object or an ID, and does not
+	// always match what bunq sends, so the result takes both.
+	key := pc.objectTypePut
+	if key == "" {
+		key = pc.goName
+	}
+	fmt.Fprintf(b, "func (s *%s) Update(ctx context.Context%s%s) (*UpdateResult[%s], error) {\n",
+		serviceName, methodParams.signature, paramsArg, pc.goName)
 
-		writePathConstruction(b, fmtStr, urlParams, pc)
+	writePathConstruction(b, fmtStr, urlParams, pc, "return nil, err")
 
-		if hasParams {
-			b.WriteString("\tbody, _, err := s.client.put(ctx, path, params)\n")
-		} else {
-			b.WriteString("\tbody, _, err := s.client.put(ctx, path, nil)\n")
-		}
-		b.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n")
-		fmt.Fprintf(b, "\treturn unmarshalObject[%s](body, %q)\n", pc.goName, key)
+	if hasParams {
+		b.WriteString("\tbody, _, err := s.client.put(ctx, path, params)\n")
 	} else {
-		fmt.Fprintf(b, "func (s *%s) Update(ctx context.Context%s%s) (int, error) {\n",
-			serviceName, methodParams.signature, paramsArg)
-
-		writePathConstruction(b, fmtStr, urlParams, pc)
-
-		if hasParams {
-			b.WriteString("\tbody, _, err := s.client.put(ctx, path, params)\n")
-		} else {
-			b.WriteString("\tbody, _, err := s.client.put(ctx, path, nil)\n")
-		}
-		writeErrorReturn(b, "int")
-		b.WriteString("\treturn unmarshalID(body)\n")
+		b.WriteString("\tbody, _, err := s.client.put(ctx, path, nil)\n")
 	}
+	b.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+	fmt.Fprintf(b, "\treturn unmarshalUpdate[%s](body, %q)\n", pc.goName, key)
 	b.WriteString("}\n\n")
 }
 
@@ -1023,7 +1551,7 @@ func generateDeleteMethod(b *strings.Builder, pc *pyClass, serviceName string) {
 	fmt.Fprintf(b, "func (s *%s) Delete(ctx context.Context%s) error {\n",
 		serviceName, methodParams.signature)
 
-	writePathConstruction(b, fmtStr, urlParams, pc)
+	writePathConstruction(b, fmtStr, urlParams, pc, "return err")
 
 	b.WriteString("\treturn s.client.delete(ctx, path)\n")
 	b.WriteString("}\n\n")
@@ -1082,7 +1610,9 @@ func buildMethodParams(urlParams []urlParam, pc *pyClass, isCreate bool) methodP
 	return methodParamsResult{signature: sig.String()}
 }
 
-func writePathConstruction(b *strings.Builder, fmtStr string, urlParams []urlParam, pc *pyClass) {
+// writePathConstruction emits the path variable for a method. UUID parameters
+// are validated first; errReturn is the statement returning err on failure.
+func writePathConstruction(b *strings.Builder, fmtStr string, urlParams []urlParam, pc *pyClass, errReturn string) {
 	if len(urlParams) == 0 {
 		fmt.Fprintf(b, "\tpath := %q\n", fmtStr)
 		return
@@ -1090,7 +1620,10 @@ func writePathConstruction(b *strings.Builder, fmtStr string, urlParams []urlPar
 
 	resolved := resolveURLParamNames(urlParams)
 	var args []string
-	for _, rp := range resolved {
+	for i, rp := range resolved {
+		if urlParams[i].isUUID {
+			fmt.Fprintf(b, "\tif err := validateUUID(%q, %s); err != nil {\n\t\t%s\n\t}\n", rp.varExpr, rp.varExpr, errReturn)
+		}
 		args = append(args, rp.varExpr)
 	}
 
@@ -1098,13 +1631,19 @@ func writePathConstruction(b *strings.Builder, fmtStr string, urlParams []urlPar
 }
 
 func writeErrorReturn(b *strings.Builder, returnType string) {
+	fmt.Fprintf(b, "\tif err != nil {\n\t\t%s\n\t}\n", zeroErrReturn(returnType))
+}
+
+// zeroErrReturn returns the statement that returns err alongside the zero
+// value of returnType.
+func zeroErrReturn(returnType string) string {
 	switch returnType {
 	case "int":
-		b.WriteString("\tif err != nil {\n\t\treturn 0, err\n\t}\n")
+		return "return 0, err"
 	case "string":
-		b.WriteString("\tif err != nil {\n\t\treturn \"\", err\n\t}\n")
+		return `return "", err`
 	default:
-		b.WriteString("\tif err != nil {\n\t\treturn nil, err\n\t}\n")
+		return "return nil, err"
 	}
 }
 
@@ -0,0 +1,352 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestUUIDKeyedEndpoint(t *testing.T) {
+	defer func() { uuidKeyedParams = map[string]bool{} }()
+
+	pc := &pyClass{
+		goName:            "Widget",
+		urlCreate:         "widget",
+		urlRead:           "widget/{}",
+		urlListing:        "widget/{}/content",
+		createReturnsUUID: true,
+	}
+	registerUUIDKeyedParams([]*pyClass{pc})
+
+	var b strings.Builder
+	generateGetMethod(&b, pc, "WidgetService")
+	generateListMethod(&b, pc, "WidgetService")
+	got := b.String()
+
+	for _, want := range []string{
+		"func (s *WidgetService) Get(ctx context.Context, widgetID string) (*Widget, error) {",
+		`if err := validateUUID("widgetID", widgetID); err != nil {` + "\n\t\treturn nil, err\n",
+		`path := fmt.Sprintf("widget/%s", widgetID)`,
+		"func (s *WidgetService) List(ctx context.Context, widgetID string, opts *ListOptions) iter.Seq2[Widget, error] {",
+		"return errIter[Widget](err)",
+		`path := fmt.Sprintf("widget/%s/content", widgetID)`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated code missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestNumericKeyedEndpoint(t *testing.T) {
+	pc := &pyClass{
+		goName:          "Widget",
+		urlCreate:       "widget",
+		urlRead:         "widget/{}",
+		createReturnsID: true,
+	}
+	registerUUIDKeyedParams([]*pyClass{pc})
+
+	var b strings.Builder
+	generateGetMethod(&b, pc, "WidgetService")
+	got := b.String()
+
+	if !strings.Contains(got, "Get(ctx context.Context, widgetID int)") {
+		t.Errorf("expected int parameter:\n%s", got)
+	}
+	if strings.Contains(got, "validateUUID") {
+		t.Errorf("unexpected UUID validation:\n%s", got)
+	}
+}
+
+func TestAnchorObject(t *testing.T) {
+	pc := &pyClass{
+		goName:   "Account",
+		isAnchor: true,
+		responseFields: []pyField{
+			{goName: "AccountBank", goType: "string", jsonTag: "AccountBank"},
+			{goName: "AccountUnknown", goType: "string", jsonTag: "AccountUnknown"},
+			{goName: "Balance", goType: "*Amount", jsonTag: "balance"},
+		},
+	}
+	resolveTypes(pc, map[string]bool{"Account": true, "AccountBank": true, "Amount": true})
+
+	var b strings.Builder
+	writeStruct(&b, pc, nil, false)
+	writeAnchorObjectMethod(&b, pc)
+	got := b.String()
+
+	for _, want := range []string{
+		"AccountBank *AccountBank `json:\"AccountBank,omitempty\"`",
+		"AccountUnknown any `json:\"AccountUnknown,omitempty\"`",
+		"Balance *Amount `json:\"balance,omitempty\"`",
+		"func (a *Account) Object() any {",
+		"case a.AccountBank != nil:\n\t\treturn a.AccountBank\n",
+		"case a.AccountUnknown != nil:\n\t\treturn a.AccountUnknown\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated code missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "a.Balance") {
+		t.Errorf("regular field treated as variant:\n%s", got)
+	}
+}
+
+func TestOverrideNumericFieldType(t *testing.T) {
+	tests := []struct {
+		field, goType, want string
+	}{
+		{"rate", "string", "FlexFloat64"},
+		{"rate_applied", "string", "FlexFloat64"},
+		{"savings_goal_progress", "string", "FlexFloat64"},
+		{"amount", "string", "FlexFloat64"},
+		{"fraction", "string", "FlexFloat64"},
+		{"amount_type", "string", "string"},
+		{"rate_status", "string", "string"},
+		{"amount", "*Amount", "*Amount"},
+		{"description", "string", "string"},
+		{"generate_data", "string", "string"},
+	}
+	for _, tt := range tests {
+		if got := overrideNumericFieldType(tt.field, tt.goType); got != tt.want {
+			t.Errorf("overrideNumericFieldType(%q, %q) = %q, want %q", tt.field, tt.goType, got, tt.want)
+		}
+	}
+}
+
+func TestIDFieldWithoutDocType(t *testing.T) {
+	body := `
+    _id_ = None
+    _monetary_account_id = None
+    _counterparty_id = None
+    _public_uuid = None
+    _description = None
+    _monetary_account_id_field_for_request = None
+`
+	pc := &pyClass{docFields: map[string]string{"description": "str"}}
+	parseFields(body, pc)
+
+	want := map[string]string{
+		"id":                  "int",
+		"monetary_account_id": "int",
+		"counterparty_id":     "int",
+		"public_uuid":         "string",
+		"description":         "string",
+	}
+	for _, f := range pc.responseFields {
+		if f.goType != want[f.jsonTag] {
+			t.Errorf("response field %s: got %s, want %s", f.jsonTag, f.goType, want[f.jsonTag])
+		}
+	}
+	if len(pc.responseFields) != len(want) {
+		t.Errorf("got %d response fields, want %d", len(pc.responseFields), len(want))
+	}
+	if len(pc.requestFields) != 1 || pc.requestFields[0].goType != "int" {
+		t.Errorf("request fields: got %+v, want one int field", pc.requestFields)
+	}
+}
+
+func TestNotificationFilterObjectType(t *testing.T) {
+	for _, py := range []string{"list[object_.NotificationFilterPush]", "list[object_.NotificationFilterUrl]", "list[object_.NotificationFilterEmail]"} {
+		if got := pythonTypeToGo(py, false); got != "[]*NotificationFilter" {
+			t.Errorf("pythonTypeToGo(%q) = %q, want []*NotificationFilter", py, got)
+		}
+	}
+}
+
+func TestPaymentLocationAndChatFields(t *testing.T) {
+	body := `
+    _geolocation = None
+`
+	pc := &pyClass{goName: "Payment", docFields: map[string]string{"geolocation": "object_.Geolocation"}}
+	parseFields(body, pc)
+
+	resolveTypes(pc, buildTypeRegistry(nil, []*pyClass{{goName: "Geolocation"}}))
+
+	want := map[string]string{
+		"geolocation":                        "*Geolocation",
+		"allow_chat":                         "*bool",
+		"additional_transaction_information": "*AdditionalTransactionInformation",
+	}
+	for _, f := range pc.responseFields {
+		if f.goType != want[f.jsonTag] {
+			t.Errorf("response field %s: got %s, want %s", f.jsonTag, f.goType, want[f.jsonTag])
+		}
+	}
+	if len(pc.responseFields) != len(want) {
+		t.Errorf("got %d response fields, want %d", len(pc.responseFields), len(want))
+	}
+}
+
+func TestAmountListType(t *testing.T) {
+	for _, py := range []string{"list[Amount]", "list[object_.Amount]", "list[AmountObject]"} {
+		if got := pythonTypeToGo(py, false); got != "[]*Amount" {
+			t.Errorf("pythonTypeToGo(%q) = %q, want []*Amount", py, got)
+		}
+	}
+}
+
+func TestMonetaryAccountScopedEndpoint(t *testing.T) {
+	pc := &pyClass{
+		goName:     "Widget",
+		urlListing: "user/{}/monetary-account/{}/widget",
+	}
+	registerUUIDKeyedParams([]*pyClass{pc})
+
+	var b strings.Builder
+	generateListMethod(&b, pc, "WidgetService")
+	got := b.String()
+
+	if !strings.Contains(got, "List(ctx context.Context, monetaryAccountID int, opts *ListOptions)") {
+		t.Errorf("expected monetaryAccountID parameter:\n%s", got)
+	}
+	if !strings.Contains(got, "s.client.resolveMonetaryAccountID(monetaryAccountID)") {
+		t.Errorf("expected the account ID to be resolved, so 0 means the primary account:\n%s", got)
+	}
+}
+
+func TestDescribeService(t *testing.T) {
+	pc := &pyClass{
+		goName:        "Payment",
+		urlCreate:     "user/{}/monetary-account/{}/payment",
+		urlRead:       "user/{}/monetary-account/{}/payment/{}",
+		urlListing:    "user/{}/monetary-account/{}/payment",
+		hasCreate:     true,
+		hasGet:        true,
+		hasList:       true,
+		requestFields: []pyField{{pythonName: "amount", goName: "Amount", goType: "*Amount", jsonTag: "amount"}},
+	}
+	registerUUIDKeyedParams([]*pyClass{pc})
+
+	sd := describeService(pc)
+	if sd.Name != "PaymentService" {
+		t.Errorf("Name = %q", sd.Name)
+	}
+	var names []string
+	for _, m := range sd.Methods {
+		names = append(names, m.Name)
+	}
+	if got := strings.Join(names, ","); got != "Create,Get,List" {
+		t.Fatalf("methods = %s, want Create,Get,List", got)
+	}
+
+	create := sd.Methods[0]
+	if create.HTTPMethod != "POST" || create.Path != pc.urlCreate {
+		t.Errorf("Create = %s %s", create.HTTPMethod, create.Path)
+	}
+	if len(create.Params) != 1 || create.Params[0] != (paramDescriptor{Name: "monetaryAccountID", Type: "int"}) {
+		t.Errorf("Create params = %+v, want [monetaryAccountID int]", create.Params)
+	}
+	if create.Body != "PaymentCreateParams" || create.Returns != "int" {
+		t.Errorf("Create body = %q, returns = %q", create.Body, create.Returns)
+	}
+
+	get := sd.Methods[1]
+	if len(get.Params) != 2 || get.Params[1] != (paramDescriptor{Name: "paymentID", Type: "int"}) || get.Returns != "*Payment" {
+		t.Errorf("Get = %+v", get)
+	}
+	if list := sd.Methods[2]; !list.Paginated || list.Returns != "Payment" || list.Body != "" {
+		t.Errorf("List = %+v", list)
+	}
+}
+
+func TestListRegistry(t *testing.T) {
+	classes := []*pyClass{
+		{goName: "Payment", hasList: true, urlListing: "user/{}/monetary-account/{}/payment"},
+		{goName: "Card", hasList: true, urlListing: "user/{}/card"},
+		{goName: "CardContent", hasList: true, urlListing: "user/{}/card/{}/content"},
+	}
+	registerUUIDKeyedParams(classes)
+
+	var b strings.Builder
+	generateListRegistry(&b, classes)
+	got := b.String()
+
+	for _, want := range []string{
+		"return anySeq(c.Payment.List(ctx, monetaryAccountID, opts))",
+		"return anySeq(c.Card.List(ctx, opts))",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("registry missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "CardContent") {
+		t.Errorf("CardContent needs a card ID and should not be registered:\n%s", got)
+	}
+}
+
+func TestMonetaryAccountDailySpentField(t *testing.T) {
+	for _, name := range []string{"MonetaryAccountBank", "MonetaryAccountJoint", "MonetaryAccountSavings"} {
+		pc := &pyClass{goName: name, docFields: map[string]string{"daily_limit": "object_.Amount"}}
+		parseFields("    _daily_limit = None\n", pc)
+		var got []string
+		for _, f := range pc.responseFields {
+			got = append(got, f.goName+" "+f.goType)
+		}
+		if !slices.Contains(got, "DailySpent *Amount") {
+			t.Errorf("%s fields = %v, want DailySpent *Amount", name, got)
+		}
+	}
+}
+
+func TestUpdateMethodResult(t *testing.T) {
+	pc := &pyClass{
+		goName:        "Widget",
+		urlUpdate:     "user/{}/widget/{}",
+		hasUpdate:     true,
+		objectTypePut: "WidgetDetail",
+		requestFields: []pyField{{pythonName: "status", goName: "Status", goType: "string", jsonTag: "status"}},
+	}
+	registerUUIDKeyedParams([]*pyClass{pc})
+
+	var b strings.Builder
+	generateUpdateMethod(&b, pc, "WidgetService")
+	got := b.String()
+
+	for _, want := range []string{
+		"func (s *WidgetService) Update(ctx context.Context, widgetID int, params WidgetUpdateParams) (*UpdateResult[Widget], error) {",
+		`return unmarshalUpdate[Widget](body, "WidgetDetail")`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated code missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestExampleParams(t *testing.T) {
+	pc := &pyClass{
+		goName: "Widget",
+		initParams: []initParam{
+			{pythonName: "amount"},
+			{pythonName: "counterparty_alias"},
+			{pythonName: "currency"},
+			{pythonName: "allow_bunqme"},
+			{pythonName: "entries"},
+			{pythonName: "description", hasDefault: true},
+		},
+		requestFields: []pyField{
+			{pythonName: "amount", goName: "Amount", goType: "*Amount", jsonTag: "amount"},
+			{pythonName: "counterparty_alias", goName: "CounterpartyAlias", goType: "*Pointer", jsonTag: "counterparty_alias"},
+			{pythonName: "currency", goName: "Currency", goType: "string", jsonTag: "currency"},
+			{pythonName: "allow_bunqme", goName: "AllowBunqme", goType: "*bool", jsonTag: "allow_bunqme"},
+			{pythonName: "entries", goName: "Entries", goType: "[]*WidgetEntry", jsonTag: "entries"},
+			{pythonName: "description", goName: "Description", goType: "string", jsonTag: "description"},
+		},
+	}
+
+	want := `bunq.WidgetCreateParams{
+		Amount: bunq.NewAmount(1, "EUR"),
+		CounterpartyAlias: &bunq.Pointer{Type: "EMAIL", Value: "bravo@bunq.com"},
+		Currency: "EUR",
+		AllowBunqme: ptr(true),
+		Entries: []*bunq.WidgetEntry{},
+	}`
+	if got := exampleParams(pc); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	pc.initParams = nil
+	if got := exampleParams(pc); got != "bunq.WidgetCreateParams{}" {
+		t.Errorf("got %s for a class without required params", got)
+	}
+}
@@ -24,16 +24,17 @@ func main() {
 
 	// 2. Connect client
 	fmt.Println("\n=== Connecting client ===")
-	fmt.Println("  Generating RSA keypair...")
-	fmt.Println("  POST /installation")
-	fmt.Println("  POST /device-server")
-	fmt.Println("  POST /session-server")
-	fmt.Println("  GET  /user/{id}/monetary-account")
-
 	client, err := bunq.NewClient(ctx, bunq.Config{
 		APIKey:      apiKey,
 		Environment: bunq.Sandbox,
 		Description: "bunq-go-sandbox-demo",
+		BootstrapObserver: func(e bunq.BootstrapEvent) {
+			status := "ok"
+			if e.Err != nil {
+				status = e.Err.Error()
+			}
+			fmt.Printf("  %-16s %6s  %s\n", e.Step, e.Duration.Round(time.Millisecond), status)
+		},
 	})
 	if err != nil {
 		log.Fatalf("Creating client: %v", err)
@@ -112,6 +113,9 @@ func main() {
 	fmt.Printf("  Description: %s\n", payment.Description)
 	fmt.Printf("  Type:        %s / %s\n", payment.Type, payment.SubType)
 	fmt.Printf("  Created:     %s\n", payment.Created)
+	if cp := payment.CounterpartyAlias; cp != nil {
+		fmt.Printf("  Counterparty: %s (%s)\n", cp.DisplayName, cp.IBAN)
+	}
 	if payment.BalanceAfterMutation != nil {
 		fmt.Printf("  Balance:     %s %s\n", payment.BalanceAfterMutation.Value, payment.BalanceAfterMutation.Currency)
 	}
@@ -0,0 +1,195 @@
+package bunq
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"iter"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+)
+
+// CursorStore persists high-water marks: the ID of the newest item an app has
+// processed, per key. bunq has no way to mark events as read, so ListUnseen
+// uses a CursorStore to skip items seen by earlier runs.
+type CursorStore interface {
+	// Load returns the mark stored under key, or 0 if there is none.
+	Load(ctx context.Context, key string) (int, error)
+	Save(ctx context.Context, key string, id int) error
+}
+
+// FileCursorStore is a CursorStore that keeps all marks in a JSON file. It is
+// safe for concurrent use within one process.
+type FileCursorStore struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// Load implements CursorStore.
+func (s *FileCursorStore) Load(ctx context.Context, key string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	marks, err := s.read()
+	if err != nil {
+		return 0, err
+	}
+	return marks[key], nil
+}
+
+// Save implements CursorStore. The file is replaced atomically.
+func (s *FileCursorStore) Save(ctx context.Context, key string, id int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	marks, err := s.read()
+	if err != nil {
+		return err
+	}
+	marks[key] = id
+
+	data, err := json.MarshalIndent(marks, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return fmt.Errorf("saving cursor: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("saving cursor: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("saving cursor: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.Path); err != nil {
+		return fmt.Errorf("saving cursor: %w", err)
+	}
+	return nil
+}
+
+func (s *FileCursorStore) read() (map[string]int, error) {
+	marks := map[string]int{}
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return marks, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading cursor: %w", err)
+	}
+	if err := json.Unmarshal(data, &marks); err != nil {
+		return nil, fmt.Errorf("parsing cursor %s: %w", s.Path, err)
+	}
+	return marks, nil
+}
+
+// ListUnseen yields the items from list that are newer than the mark stored
+// under key, newest first, e.g.
+//
+//	store := &bunq.FileCursorStore{Path: "cursor.json"}
+//	for event, err := range bunq.ListUnseen(ctx, store, "events", client.Event.List) {
+//		...
+//	}
+//
+// Once every item has been yielded without error, the mark is advanced to the
+// newest one, so the next call only yields items created since. If iteration
+// stops early or fails, the mark is left unchanged and the items are yielded
+// again next time. Items must have an integer ID field.
+func ListUnseen[T any](ctx context.Context, store CursorStore, key string, list func(ctx context.Context, opts *ListOptions) iter.Seq2[T, error]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		mark, err := store.Load(ctx, key)
+		if err != nil {
+			var zero T
+			yield(zero, err)
+			return
+		}
+
+		newest := mark
+		for item, err := range list(ctx, nil) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			id, ok := itemID(item)
+			if !ok {
+				var zero T
+				yield(zero, fmt.Errorf("ListUnseen: %T has no ID", item))
+				return
+			}
+			if id <= mark {
+				break
+			}
+			newest = max(newest, id)
+			if !yield(item, nil) {
+				return
+			}
+		}
+
+		if newest != mark {
+			if err := store.Save(ctx, key, newest); err != nil {
+				var zero T
+				yield(zero, err)
+			}
+		}
+	}
+}
+
+// ListResumable yields the items from list, newest first, resuming after
+// *pos: the ID of the last item an earlier iteration yielded. A *pos of 0
+// starts at the newest item. After each item, *pos is set to its ID, so if
+// the caller stops early or iteration fails, passing the same pos again
+// continues with the next older item, e.g.
+//
+//	var pos int
+//	for p, err := range bunq.ListResumable(ctx, listPayments, nil, &pos) {
+//		...
+//	}
+//
+// Once the list is exhausted *pos is the oldest item, so resuming yields
+// nothing. opts must not set OlderID or NewerID. Items must have an integer
+// ID field.
+func ListResumable[T any](ctx context.Context, list func(ctx context.Context, opts *ListOptions) iter.Seq2[T, error], opts *ListOptions, pos *int) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var o ListOptions
+		if opts != nil {
+			o = *opts
+		}
+		o.OlderID = *pos
+		for item, err := range list(ctx, &o) {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			id, ok := itemID(item)
+			if !ok {
+				var zero T
+				yield(zero, fmt.Errorf("ListResumable: %T has no ID", item))
+				return
+			}
+			*pos = id
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// itemID returns the value of item's integer ID field, if it has one.
+func itemID(item any) (int, bool) {
+	v := reflect.Indirect(reflect.ValueOf(item))
+	if v.Kind() != reflect.Struct {
+		return 0, false
+	}
+	f := v.FieldByName("ID")
+	if !f.IsValid() || f.Kind() != reflect.Int {
+		return 0, false
+	}
+	return int(f.Int()), true
+}
@@ -0,0 +1,50 @@
+//go:build bunqdebug
+
+package bunq
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// The helpers in this file help diagnose signature errors, such as
+// "server signature verification failed". They are only built with the
+// bunqdebug build tag: go build -tags bunqdebug.
+
+// SignatureDebug shows what a signature covers.
+type SignatureDebug struct {
+	SHA256    string // hex-encoded SHA-256 hash of the body, which is what is signed
+	Signature string // base64-encoded, as in the X-Bunq-*-Signature headers
+}
+
+// DebugSign signs a request body the way the client does before sending it.
+// Signatures are deterministic, so a signature differing from the one sent
+// means the body bytes differ.
+func DebugSign(privateKey *rsa.PrivateKey, body []byte) (SignatureDebug, error) {
+	sig, err := signRequest(privateKey, body)
+	if err != nil {
+		return SignatureDebug{}, err
+	}
+	return SignatureDebug{SHA256: bodyHash(body), Signature: sig}, nil
+}
+
+// DebugSign signs a request body with the client's private key. See the
+// DebugSign function.
+func (c *Client) DebugSign(body []byte) (SignatureDebug, error) {
+	return DebugSign(c.privateKey, body)
+}
+
+// DebugVerifyResponse checks a response body against the
+// X-Bunq-Server-Signature it came with, as the client does, using the key
+// from Client.ServerPublicKey. The returned hash is that of the body as
+// given, to compare with what was received.
+func DebugVerifyResponse(serverPubKey *rsa.PublicKey, body []byte, signature string) (SignatureDebug, error) {
+	d := SignatureDebug{SHA256: bodyHash(body), Signature: signature}
+	return d, verifyResponse(serverPubKey, body, signature)
+}
+
+func bodyHash(body []byte) string {
+	h := sha256.Sum256(body)
+	return hex.EncodeToString(h[:])
+}
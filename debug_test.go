@@ -0,0 +1,32 @@
+//go:build bunqdebug
+
+package bunq
+
+import "testing"
+
+func TestDebugSignature(t *testing.T) {
+	key, err := testKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	body := []byte(`{"amount":{"value":"1.00","currency":"EUR"}}`)
+
+	c := &Client{privateKey: key}
+	d, err := c.DebugSign(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := signRequest(key, body); d.Signature != want {
+		t.Errorf("signature differs from signRequest")
+	}
+	if d.SHA256 != "546d5ace1e786000c995b92c942f4baa113d8b877ebec0d75d0e019ab4bbba2e" {
+		t.Errorf("unexpected hash %q", d.SHA256)
+	}
+
+	if _, err := DebugVerifyResponse(&key.PublicKey, body, d.Signature); err != nil {
+		t.Errorf("verifying own signature: %v", err)
+	}
+	if _, err := DebugVerifyResponse(&key.PublicKey, append(body, ' '), d.Signature); err == nil {
+		t.Error("expected verification to fail for a changed body")
+	}
+}
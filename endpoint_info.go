@@ -0,0 +1,85 @@
+package bunq
+
+import (
+	"cmp"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// EndpointMeta describes the bunq URL templates behind a generated type. Each
+// "{}" in a template is a path parameter, e.g. the user or monetary account ID.
+// An empty template means the type does not support that operation.
+type EndpointMeta struct {
+	Create string // POST
+	Read   string // GET of a single object
+	List   string // GET of a collection
+	Update string // PUT
+	Delete string // DELETE
+}
+
+// EndpointInfo returns the endpoint metadata for a generated type name such as
+// "Payment". It reports false if the type has no service.
+func EndpointInfo(typeName string) (EndpointMeta, bool) {
+	meta, ok := endpointRegistry[typeName]
+	return meta, ok
+}
+
+// Endpoint is a single operation of a generated type: an HTTP method and the
+// URL template it is sent to.
+type Endpoint struct {
+	Type     string // generated type name, e.g. "Payment"
+	Method   string // http.MethodGet etc.
+	Template string // e.g. "user/{}/monetary-account/{}/payment/{}"
+}
+
+// Endpoints returns every endpoint of the generated services, sorted by
+// template and method, e.g. to configure a mock server. Read and List both
+// use GET and may share a template; it is listed once per type.
+func Endpoints() []Endpoint {
+	var eps []Endpoint
+	for typeName, meta := range endpointRegistry {
+		seen := map[Endpoint]bool{}
+		for _, ep := range []Endpoint{
+			{typeName, http.MethodPost, meta.Create},
+			{typeName, http.MethodGet, meta.Read},
+			{typeName, http.MethodGet, meta.List},
+			{typeName, http.MethodPut, meta.Update},
+			{typeName, http.MethodDelete, meta.Delete},
+		} {
+			if ep.Template != "" && !seen[ep] {
+				seen[ep] = true
+				eps = append(eps, ep)
+			}
+		}
+	}
+	slices.SortFunc(eps, func(a, b Endpoint) int {
+		return cmp.Or(
+			strings.Compare(a.Template, b.Template),
+			strings.Compare(a.Method, b.Method),
+			strings.Compare(a.Type, b.Type),
+		)
+	})
+	return eps
+}
+
+// Match reports whether path, relative to the API base URL and without a
+// query string, fits the endpoint's template. Each "{}" matches one
+// non-empty path segment.
+func (e Endpoint) Match(path string) bool {
+	want := strings.Split(e.Template, "/")
+	got := strings.Split(strings.Trim(path, "/"), "/")
+	if len(want) != len(got) {
+		return false
+	}
+	for i, w := range want {
+		if w == "{}" {
+			if got[i] == "" {
+				return false
+			}
+		} else if w != got[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -183,6 +183,8 @@ type Payment struct {
 	PaymentAutoAllocateInstance *PaymentAutoAllocateInstance `json:"payment_auto_allocate_instance,omitempty"`
 	PaymentSuspendedOutgoing *PaymentSuspendedOutgoing `json:"payment_suspended_outgoing,omitempty"`
 	PaymentFee *PaymentFee `json:"payment_fee,omitempty"`
+	AllowChat *bool `json:"allow_chat,omitempty"`
+	AdditionalTransactionInformation *AdditionalTransactionInformation `json:"additional_transaction_information,omitempty"`
 }
 
 type PaymentCreateParams struct {
@@ -216,6 +218,8 @@ type PaymentBatchCreateParams struct {
 
 type PaymentBatchUpdateParams struct {
 	Payments []*Payment `json:"payments,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type GinmonTransaction struct {
@@ -301,6 +305,8 @@ type BunqMeTabUpdateParams struct {
 	BunqmeTabEntry *BunqMeTabEntry `json:"bunqme_tab_entry,omitempty"`
 	Status string `json:"status,omitempty"`
 	EventID int `json:"event_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type BunqMeTabEntry struct {
@@ -410,6 +416,8 @@ type CardGeneratedCvc2CreateParams struct {
 
 type CardGeneratedCvc2UpdateParams struct {
 	Type string `json:"type,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type CardDebit struct {
@@ -533,6 +541,8 @@ type CardUpdateParams struct {
 	PreferredNameOnCard string `json:"preferred_name_on_card,omitempty"`
 	SecondLine string `json:"second_line,omitempty"`
 	CancellationReason string `json:"cancellation_reason,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type CertificatePinned struct {
@@ -581,6 +591,8 @@ type CompanyUpdateParams struct {
 	VATNumber *CompanyVatNumber `json:"vat_number,omitempty"`
 	VATNumbers []*CompanyVatNumber `json:"vat_numbers,omitempty"`
 	SignupTrackType string `json:"signup_track_type,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type UserCompany struct {
@@ -636,6 +648,8 @@ type UserCompanyUpdateParams struct {
 	SubStatus string `json:"sub_status,omitempty"`
 	SessionTimeout int `json:"session_timeout,omitempty"`
 	DailyLimitWithoutConfirmationLogin *Amount `json:"daily_limit_without_confirmation_login,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type Customer struct {
@@ -725,7 +739,7 @@ type CurrencyConversionQuote struct {
 	Status string `json:"status,omitempty"`
 	AmountSource *Amount `json:"amount_source,omitempty"`
 	AmountTarget *Amount `json:"amount_target,omitempty"`
-	Rate string `json:"rate,omitempty"`
+	Rate FlexFloat64 `json:"rate,omitempty"`
 	TimeExpiry string `json:"time_expiry,omitempty"`
 }
 
@@ -745,6 +759,8 @@ type CurrencyConversionQuoteUpdateParams struct {
 	OrderType string `json:"order_type,omitempty"`
 	CounterpartyAlias *Pointer `json:"counterparty_alias,omitempty"`
 	Status string `json:"status,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type CurrencyConversion struct {
@@ -753,7 +769,7 @@ type CurrencyConversion struct {
 	Updated string `json:"updated,omitempty"`
 	Status string `json:"status,omitempty"`
 	DateDeliveryExpected string `json:"date_delivery_expected,omitempty"`
-	Rate string `json:"rate,omitempty"`
+	Rate FlexFloat64 `json:"rate,omitempty"`
 	Amount *Amount `json:"amount,omitempty"`
 	CounterAmount *Amount `json:"counter_amount,omitempty"`
 	GroupUUID string `json:"group_uuid,omitempty"`
@@ -783,6 +799,15 @@ type Device struct {
 	DeviceServer *DeviceServer `json:"DeviceServer,omitempty"`
 }
 
+// Object returns the concrete object held by the Device, or nil if none is set.
+func (a *Device) Object() any {
+	switch {
+	case a.DeviceServer != nil:
+		return a.DeviceServer
+	}
+	return nil
+}
+
 type DraftPayment struct {
 	ID int `json:"id,omitempty"`
 	MonetaryAccountID int `json:"monetary_account_id,omitempty"`
@@ -810,6 +835,8 @@ type DraftPaymentUpdateParams struct {
 	PreviousUpdatedTimestamp string `json:"previous_updated_timestamp,omitempty"`
 	NumberOfRequiredAccepts int `json:"number_of_required_accepts,omitempty"`
 	Schedule *Schedule `json:"schedule,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type Schedule struct {
@@ -882,6 +909,8 @@ type SchedulePaymentUpdateParams struct {
 	Payment *SchedulePaymentEntry `json:"payment,omitempty"`
 	Schedule *Schedule `json:"schedule,omitempty"`
 	Purpose string `json:"purpose,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type SchedulePaymentBatch struct {
@@ -897,6 +926,8 @@ type SchedulePaymentBatchCreateParams struct {
 type SchedulePaymentBatchUpdateParams struct {
 	Payments []*SchedulePaymentEntry `json:"payments,omitempty"`
 	Schedule *Schedule `json:"schedule,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type ScheduleInstance struct {
@@ -911,6 +942,8 @@ type ScheduleInstance struct {
 
 type ScheduleInstanceUpdateParams struct {
 	State string `json:"state,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type MasterCardAction struct {
@@ -990,7 +1023,7 @@ type MasterCardActionRefund struct {
 type CashbackPayoutItem struct {
 	Status string `json:"status,omitempty"`
 	Amount *Amount `json:"amount,omitempty"`
-	RateApplied string `json:"rate_applied,omitempty"`
+	RateApplied FlexFloat64 `json:"rate_applied,omitempty"`
 	TransactionCategory *AdditionalTransactionInformationCategory `json:"transaction_category,omitempty"`
 	UserPartnerPromotion *UserPartnerPromotionCashback `json:"user_partner_promotion,omitempty"`
 }
@@ -1066,6 +1099,8 @@ type RequestInquiryBatchUpdateParams struct {
 	Status string `json:"status,omitempty"`
 	TotalAmountInquired *Amount `json:"total_amount_inquired,omitempty"`
 	EventID int `json:"event_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type RequestInquiry struct {
@@ -1128,6 +1163,8 @@ type RequestInquiryUpdateParams struct {
 	AllowBunqme *bool `json:"allow_bunqme,omitempty"`
 	RedirectURL string `json:"redirect_url,omitempty"`
 	EventID int `json:"event_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type RequestResponse struct {
@@ -1170,6 +1207,8 @@ type RequestResponseUpdateParams struct {
 	AddressShipping *Address `json:"address_shipping,omitempty"`
 	AddressBilling *Address `json:"address_billing,omitempty"`
 	CurrencyConversionQuoteID int `json:"currency_conversion_quote_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type WhitelistResult struct {
@@ -1194,7 +1233,7 @@ type TransferwiseTransfer struct {
 	StatusTransferwiseIssue string `json:"status_transferwise_issue,omitempty"`
 	AmountSource *Amount `json:"amount_source,omitempty"`
 	AmountTarget *Amount `json:"amount_target,omitempty"`
-	Rate string `json:"rate,omitempty"`
+	Rate FlexFloat64 `json:"rate,omitempty"`
 	Reference string `json:"reference,omitempty"`
 	PayInReference string `json:"pay_in_reference,omitempty"`
 	TimeDeliveryEstimate string `json:"time_delivery_estimate,omitempty"`
@@ -1215,7 +1254,7 @@ type TransferwiseQuote struct {
 	AmountSource *Amount `json:"amount_source,omitempty"`
 	AmountTarget *Amount `json:"amount_target,omitempty"`
 	AmountFee *Amount `json:"amount_fee,omitempty"`
-	Rate string `json:"rate,omitempty"`
+	Rate FlexFloat64 `json:"rate,omitempty"`
 	TimeDeliveryEstimate string `json:"time_delivery_estimate,omitempty"`
 }
 
@@ -1260,6 +1299,8 @@ type ShareInviteMonetaryAccountInquiryUpdateParams struct {
 	ShareType string `json:"share_type,omitempty"`
 	StartDate string `json:"start_date,omitempty"`
 	EndDate string `json:"end_date,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type ShareInviteMonetaryAccountResponse struct {
@@ -1283,6 +1324,8 @@ type ShareInviteMonetaryAccountResponse struct {
 type ShareInviteMonetaryAccountResponseUpdateParams struct {
 	Status string `json:"status,omitempty"`
 	CardID int `json:"card_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type SofortMerchantTransaction struct {
@@ -1456,6 +1499,7 @@ type MonetaryAccountBank struct {
 	OpenBankingAccount *OpenBankingAccount `json:"open_banking_account,omitempty"`
 	BirdeeInvestmentPortfolio *BirdeeInvestmentPortfolio `json:"birdee_investment_portfolio,omitempty"`
 	AllAccess []*MonetaryAccountAccess `json:"all_access,omitempty"`
+	DailySpent *Amount `json:"daily_spent,omitempty"`
 }
 
 type MonetaryAccountBankCreateParams struct {
@@ -1484,6 +1528,8 @@ type MonetaryAccountBankUpdateParams struct {
 	DisplayName string `json:"display_name,omitempty"`
 	Setting *MonetaryAccountSetting `json:"setting,omitempty"`
 	CountryIBAN string `json:"country_iban,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type MonetaryAccountProfile struct {
@@ -1570,7 +1616,7 @@ type BirdeePortfolioAllocation struct {
 	Weight string `json:"weight,omitempty"`
 	Quantity string `json:"quantity,omitempty"`
 	Price string `json:"price,omitempty"`
-	Amount string `json:"amount,omitempty"`
+	Amount FlexFloat64 `json:"amount,omitempty"`
 }
 
 type MonetaryAccountAccess struct {
@@ -1606,6 +1652,7 @@ type MonetaryAccountCard struct {
 	OpenBankingAccount *OpenBankingAccount `json:"open_banking_account,omitempty"`
 	BirdeeInvestmentPortfolio *BirdeeInvestmentPortfolio `json:"birdee_investment_portfolio,omitempty"`
 	AllAccess []*MonetaryAccountAccess `json:"all_access,omitempty"`
+	DailySpent *Amount `json:"daily_spent,omitempty"`
 }
 
 type MonetaryAccountExternalSavings struct {
@@ -1640,6 +1687,7 @@ type MonetaryAccountExternalSavings struct {
 	OpenBankingAccount *OpenBankingAccount `json:"open_banking_account,omitempty"`
 	BirdeeInvestmentPortfolio *BirdeeInvestmentPortfolio `json:"birdee_investment_portfolio,omitempty"`
 	AllAccess []*MonetaryAccountAccess `json:"all_access,omitempty"`
+	DailySpent *Amount `json:"daily_spent,omitempty"`
 }
 
 type MonetaryAccountExternalSavingsCreateParams struct {
@@ -1670,6 +1718,8 @@ type MonetaryAccountExternalSavingsUpdateParams struct {
 	DisplayName string `json:"display_name,omitempty"`
 	Setting *MonetaryAccountSetting `json:"setting,omitempty"`
 	SavingsGoal *Amount `json:"savings_goal,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type MonetaryAccountExternal struct {
@@ -1703,6 +1753,7 @@ type MonetaryAccountExternal struct {
 	CoOwnerInvite *CoOwnerInviteResponse `json:"co_owner_invite,omitempty"`
 	BirdeeInvestmentPortfolio *BirdeeInvestmentPortfolio `json:"birdee_investment_portfolio,omitempty"`
 	AllAccess []*MonetaryAccountAccess `json:"all_access,omitempty"`
+	DailySpent *Amount `json:"daily_spent,omitempty"`
 }
 
 type MonetaryAccountExternalCreateParams struct {
@@ -1731,6 +1782,8 @@ type MonetaryAccountExternalUpdateParams struct {
 	ReasonDescription string `json:"reason_description,omitempty"`
 	DisplayName string `json:"display_name,omitempty"`
 	Setting *MonetaryAccountSetting `json:"setting,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type MonetaryAccountJoint struct {
@@ -1762,6 +1815,7 @@ type MonetaryAccountJoint struct {
 	OpenBankingAccount *OpenBankingAccount `json:"open_banking_account,omitempty"`
 	BirdeeInvestmentPortfolio *BirdeeInvestmentPortfolio `json:"birdee_investment_portfolio,omitempty"`
 	AllAccess []*MonetaryAccountAccess `json:"all_access,omitempty"`
+	DailySpent *Amount `json:"daily_spent,omitempty"`
 }
 
 type MonetaryAccountJointCreateParams struct {
@@ -1792,6 +1846,8 @@ type MonetaryAccountJointUpdateParams struct {
 	ReasonDescription string `json:"reason_description,omitempty"`
 	AllCoOwner []*CoOwner `json:"all_co_owner,omitempty"`
 	Setting *MonetaryAccountSetting `json:"setting,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type MonetaryAccountSavings struct {
@@ -1825,6 +1881,7 @@ type MonetaryAccountSavings struct {
 	OpenBankingAccount *OpenBankingAccount `json:"open_banking_account,omitempty"`
 	BirdeeInvestmentPortfolio *BirdeeInvestmentPortfolio `json:"birdee_investment_portfolio,omitempty"`
 	AllAccess []*MonetaryAccountAccess `json:"all_access,omitempty"`
+	DailySpent *Amount `json:"daily_spent,omitempty"`
 }
 
 type MonetaryAccountSavingsCreateParams struct {
@@ -1853,6 +1910,8 @@ type MonetaryAccountSavingsUpdateParams struct {
 	AllCoOwner []*CoOwner `json:"all_co_owner,omitempty"`
 	Setting *MonetaryAccountSetting `json:"setting,omitempty"`
 	SavingsGoal *Amount `json:"savings_goal,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type MonetaryAccount struct {
@@ -1883,6 +1942,31 @@ type MonetaryAccount struct {
 	MonetaryAccountCard *MonetaryAccountCard `json:"MonetaryAccountCard,omitempty"`
 }
 
+// Object returns the concrete object held by the MonetaryAccount, or nil if none is set.
+func (a *MonetaryAccount) Object() any {
+	switch {
+	case a.MonetaryAccountLight != nil:
+		return a.MonetaryAccountLight
+	case a.MonetaryAccountBank != nil:
+		return a.MonetaryAccountBank
+	case a.MonetaryAccountExternal != nil:
+		return a.MonetaryAccountExternal
+	case a.MonetaryAccountInvestment != nil:
+		return a.MonetaryAccountInvestment
+	case a.MonetaryAccountJoint != nil:
+		return a.MonetaryAccountJoint
+	case a.MonetaryAccountSavings != nil:
+		return a.MonetaryAccountSavings
+	case a.MonetaryAccountSwitchService != nil:
+		return a.MonetaryAccountSwitchService
+	case a.MonetaryAccountExternalSavings != nil:
+		return a.MonetaryAccountExternalSavings
+	case a.MonetaryAccountCard != nil:
+		return a.MonetaryAccountCard
+	}
+	return nil
+}
+
 type MonetaryAccountLight struct {
 	ID int `json:"id,omitempty"`
 	Created string `json:"created,omitempty"`
@@ -1918,6 +2002,7 @@ type MonetaryAccountLight struct {
 	OpenBankingAccount *OpenBankingAccount `json:"open_banking_account,omitempty"`
 	BirdeeInvestmentPortfolio *BirdeeInvestmentPortfolio `json:"birdee_investment_portfolio,omitempty"`
 	AllAccess []*MonetaryAccountAccess `json:"all_access,omitempty"`
+	DailySpent *Amount `json:"daily_spent,omitempty"`
 }
 
 type MonetaryAccountInvestment struct {
@@ -1949,6 +2034,7 @@ type MonetaryAccountInvestment struct {
 	CoOwnerInvite *CoOwnerInviteResponse `json:"co_owner_invite,omitempty"`
 	OpenBankingAccount *OpenBankingAccount `json:"open_banking_account,omitempty"`
 	AllAccess []*MonetaryAccountAccess `json:"all_access,omitempty"`
+	DailySpent *Amount `json:"daily_spent,omitempty"`
 }
 
 type MonetaryAccountSwitchService struct {
@@ -1992,6 +2078,8 @@ type NoteAttachmentAdyenCardTransactionCreateParams struct {
 type NoteAttachmentAdyenCardTransactionUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextAdyenCardTransaction struct {
@@ -2008,6 +2096,8 @@ type NoteTextAdyenCardTransactionCreateParams struct {
 
 type NoteTextAdyenCardTransactionUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentBankSwitchServiceNetherlandsIncomingPayment struct {
@@ -2027,6 +2117,8 @@ type NoteAttachmentBankSwitchServiceNetherlandsIncomingPaymentCreateParams struc
 type NoteAttachmentBankSwitchServiceNetherlandsIncomingPaymentUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextBankSwitchServiceNetherlandsIncomingPayment struct {
@@ -2043,6 +2135,8 @@ type NoteTextBankSwitchServiceNetherlandsIncomingPaymentCreateParams struct {
 
 type NoteTextBankSwitchServiceNetherlandsIncomingPaymentUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentBunqMeFundraiserResult struct {
@@ -2062,6 +2156,8 @@ type NoteAttachmentBunqMeFundraiserResultCreateParams struct {
 type NoteAttachmentBunqMeFundraiserResultUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextBunqMeFundraiserResult struct {
@@ -2078,6 +2174,8 @@ type NoteTextBunqMeFundraiserResultCreateParams struct {
 
 type NoteTextBunqMeFundraiserResultUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentDraftPayment struct {
@@ -2097,6 +2195,8 @@ type NoteAttachmentDraftPaymentCreateParams struct {
 type NoteAttachmentDraftPaymentUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextDraftPayment struct {
@@ -2113,6 +2213,8 @@ type NoteTextDraftPaymentCreateParams struct {
 
 type NoteTextDraftPaymentUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentIdealMerchantTransaction struct {
@@ -2132,6 +2234,8 @@ type NoteAttachmentIdealMerchantTransactionCreateParams struct {
 type NoteAttachmentIdealMerchantTransactionUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextIdealMerchantTransaction struct {
@@ -2148,6 +2252,8 @@ type NoteTextIdealMerchantTransactionCreateParams struct {
 
 type NoteTextIdealMerchantTransactionUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentMasterCardAction struct {
@@ -2167,6 +2273,8 @@ type NoteAttachmentMasterCardActionCreateParams struct {
 type NoteAttachmentMasterCardActionUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextMasterCardAction struct {
@@ -2183,6 +2291,8 @@ type NoteTextMasterCardActionCreateParams struct {
 
 type NoteTextMasterCardActionUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentOpenBankingMerchantTransaction struct {
@@ -2202,6 +2312,8 @@ type NoteAttachmentOpenBankingMerchantTransactionCreateParams struct {
 type NoteAttachmentOpenBankingMerchantTransactionUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextOpenBankingMerchantTransaction struct {
@@ -2218,6 +2330,8 @@ type NoteTextOpenBankingMerchantTransactionCreateParams struct {
 
 type NoteTextOpenBankingMerchantTransactionUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentPaymentBatch struct {
@@ -2237,6 +2351,8 @@ type NoteAttachmentPaymentBatchCreateParams struct {
 type NoteAttachmentPaymentBatchUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextPaymentBatch struct {
@@ -2253,6 +2369,8 @@ type NoteTextPaymentBatchCreateParams struct {
 
 type NoteTextPaymentBatchUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentPaymentDelayed struct {
@@ -2272,6 +2390,8 @@ type NoteAttachmentPaymentDelayedCreateParams struct {
 type NoteAttachmentPaymentDelayedUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextPaymentDelayed struct {
@@ -2288,6 +2408,8 @@ type NoteTextPaymentDelayedCreateParams struct {
 
 type NoteTextPaymentDelayedUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentPayment struct {
@@ -2307,6 +2429,8 @@ type NoteAttachmentPaymentCreateParams struct {
 type NoteAttachmentPaymentUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextPayment struct {
@@ -2323,6 +2447,8 @@ type NoteTextPaymentCreateParams struct {
 
 type NoteTextPaymentUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentRequestInquiryBatch struct {
@@ -2342,6 +2468,8 @@ type NoteAttachmentRequestInquiryBatchCreateParams struct {
 type NoteAttachmentRequestInquiryBatchUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextRequestInquiryBatch struct {
@@ -2358,6 +2486,8 @@ type NoteTextRequestInquiryBatchCreateParams struct {
 
 type NoteTextRequestInquiryBatchUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentRequestInquiry struct {
@@ -2377,6 +2507,8 @@ type NoteAttachmentRequestInquiryCreateParams struct {
 type NoteAttachmentRequestInquiryUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextRequestInquiry struct {
@@ -2393,6 +2525,8 @@ type NoteTextRequestInquiryCreateParams struct {
 
 type NoteTextRequestInquiryUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentRequestResponse struct {
@@ -2412,6 +2546,8 @@ type NoteAttachmentRequestResponseCreateParams struct {
 type NoteAttachmentRequestResponseUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextRequestResponse struct {
@@ -2428,6 +2564,8 @@ type NoteTextRequestResponseCreateParams struct {
 
 type NoteTextRequestResponseUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentScheduleInstance struct {
@@ -2447,6 +2585,8 @@ type NoteAttachmentScheduleInstanceCreateParams struct {
 type NoteAttachmentScheduleInstanceUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextScheduleInstance struct {
@@ -2463,6 +2603,8 @@ type NoteTextScheduleInstanceCreateParams struct {
 
 type NoteTextScheduleInstanceUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentSchedulePaymentBatch struct {
@@ -2482,6 +2624,8 @@ type NoteAttachmentSchedulePaymentBatchCreateParams struct {
 type NoteAttachmentSchedulePaymentBatchUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextSchedulePaymentBatch struct {
@@ -2498,6 +2642,8 @@ type NoteTextSchedulePaymentBatchCreateParams struct {
 
 type NoteTextSchedulePaymentBatchUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentSchedulePayment struct {
@@ -2517,6 +2663,8 @@ type NoteAttachmentSchedulePaymentCreateParams struct {
 type NoteAttachmentSchedulePaymentUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextSchedulePayment struct {
@@ -2533,6 +2681,8 @@ type NoteTextSchedulePaymentCreateParams struct {
 
 type NoteTextSchedulePaymentUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentScheduleRequestBatch struct {
@@ -2552,6 +2702,8 @@ type NoteAttachmentScheduleRequestBatchCreateParams struct {
 type NoteAttachmentScheduleRequestBatchUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextScheduleRequestBatch struct {
@@ -2568,6 +2720,8 @@ type NoteTextScheduleRequestBatchCreateParams struct {
 
 type NoteTextScheduleRequestBatchUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentScheduleRequest struct {
@@ -2587,6 +2741,8 @@ type NoteAttachmentScheduleRequestCreateParams struct {
 type NoteAttachmentScheduleRequestUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextScheduleRequest struct {
@@ -2603,6 +2759,8 @@ type NoteTextScheduleRequestCreateParams struct {
 
 type NoteTextScheduleRequestUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentSofortMerchantTransaction struct {
@@ -2622,6 +2780,8 @@ type NoteAttachmentSofortMerchantTransactionCreateParams struct {
 type NoteAttachmentSofortMerchantTransactionUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextSofortMerchantTransaction struct {
@@ -2638,6 +2798,8 @@ type NoteTextSofortMerchantTransactionCreateParams struct {
 
 type NoteTextSofortMerchantTransactionUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteAttachmentWhitelistResult struct {
@@ -2657,6 +2819,8 @@ type NoteAttachmentWhitelistResultCreateParams struct {
 type NoteAttachmentWhitelistResultUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	AttachmentID int `json:"attachment_id,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NoteTextWhitelistResult struct {
@@ -2673,14 +2837,16 @@ type NoteTextWhitelistResultCreateParams struct {
 
 type NoteTextWhitelistResultUpdateParams struct {
 	Content string `json:"content,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type NotificationFilterEmail struct {
-	NotificationFilters []*NotificationFilterEmail `json:"notification_filters,omitempty"`
+	NotificationFilters []*NotificationFilter `json:"notification_filters,omitempty"`
 }
 
 type NotificationFilterEmailCreateParams struct {
-	NotificationFilters []*NotificationFilterEmail `json:"notification_filters,omitempty"`
+	NotificationFilters []*NotificationFilter `json:"notification_filters,omitempty"`
 }
 
 type NotificationFilterFailure struct {
@@ -2697,27 +2863,27 @@ type NotificationFilterFailureCreateParams struct {
 }
 
 type NotificationFilterPush struct {
-	NotificationFilters []*NotificationFilterPush `json:"notification_filters,omitempty"`
+	NotificationFilters []*NotificationFilter `json:"notification_filters,omitempty"`
 }
 
 type NotificationFilterPushCreateParams struct {
-	NotificationFilters []*NotificationFilterPush `json:"notification_filters,omitempty"`
+	NotificationFilters []*NotificationFilter `json:"notification_filters,omitempty"`
 }
 
 type NotificationFilterUrl struct {
-	NotificationFilters []*NotificationFilterUrl `json:"notification_filters,omitempty"`
+	NotificationFilters []*NotificationFilter `json:"notification_filters,omitempty"`
 }
 
 type NotificationFilterUrlCreateParams struct {
-	NotificationFilters []*NotificationFilterUrl `json:"notification_filters,omitempty"`
+	NotificationFilters []*NotificationFilter `json:"notification_filters,omitempty"`
 }
 
 type NotificationFilterUrlMonetaryAccount struct {
-	NotificationFilters []*NotificationFilterUrl `json:"notification_filters,omitempty"`
+	NotificationFilters []*NotificationFilter `json:"notification_filters,omitempty"`
 }
 
 type NotificationFilterUrlMonetaryAccountCreateParams struct {
-	NotificationFilters []*NotificationFilterUrl `json:"notification_filters,omitempty"`
+	NotificationFilters []*NotificationFilter `json:"notification_filters,omitempty"`
 }
 
 type ChatMessage struct{}
@@ -2729,6 +2895,21 @@ type User struct {
 	UserPaymentServiceProvider *UserPaymentServiceProvider `json:"UserPaymentServiceProvider,omitempty"`
 }
 
+// Object returns the concrete object held by the User, or nil if none is set.
+func (a *User) Object() any {
+	switch {
+	case a.UserPerson != nil:
+		return a.UserPerson
+	case a.UserCompany != nil:
+		return a.UserCompany
+	case a.UserApiKey != nil:
+		return a.UserApiKey
+	case a.UserPaymentServiceProvider != nil:
+		return a.UserPaymentServiceProvider
+	}
+	return nil
+}
+
 type UserPerson struct {
 	ID int `json:"id,omitempty"`
 	Created string `json:"created,omitempty"`
@@ -2791,6 +2972,8 @@ type UserPersonUpdateParams struct {
 	DailyLimitWithoutConfirmationLogin *Amount `json:"daily_limit_without_confirmation_login,omitempty"`
 	DisplayName string `json:"display_name,omitempty"`
 	SignupTrackType string `json:"signup_track_type,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type UserApiKey struct {
@@ -2828,6 +3011,8 @@ type OauthCallbackUrlCreateParams struct {
 
 type OauthCallbackUrlUpdateParams struct {
 	URL string `json:"url,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type OauthClient struct {
@@ -2845,6 +3030,8 @@ type OauthClientCreateParams struct {
 
 type OauthClientUpdateParams struct {
 	Status string `json:"status,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type PaymentAutoAllocateDefinition struct {
@@ -2879,6 +3066,8 @@ type PaymentAutoAllocateUpdateParams struct {
 	PaymentID int `json:"payment_id,omitempty"`
 	Type string `json:"type,omitempty"`
 	Definition []*PaymentAutoAllocateDefinition `json:"definition,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type PaymentAutoAllocateUser struct {
@@ -2926,6 +3115,8 @@ type PaymentServiceProviderDraftPaymentUpdateParams struct {
 	Description string `json:"description,omitempty"`
 	Amount *Amount `json:"amount,omitempty"`
 	Status string `json:"status,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type PaymentServiceProviderIssuerTransaction struct {
@@ -2958,6 +3149,8 @@ type PaymentServiceProviderIssuerTransactionUpdateParams struct {
 	URLRedirect string `json:"url_redirect,omitempty"`
 	TimeExpiry string `json:"time_expiry,omitempty"`
 	Status string `json:"status,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type PermittedIp struct {
@@ -2973,6 +3166,8 @@ type PermittedIpCreateParams struct {
 type PermittedIpUpdateParams struct {
 	IP string `json:"ip,omitempty"`
 	Status string `json:"status,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type SandboxUserCompany struct {
@@ -3067,7 +3262,7 @@ type TransferwiseQuoteTemporary struct {
 	QuoteID int `json:"quote_id,omitempty"`
 	AmountSource *Amount `json:"amount_source,omitempty"`
 	AmountTarget *Amount `json:"amount_target,omitempty"`
-	Rate string `json:"rate,omitempty"`
+	Rate FlexFloat64 `json:"rate,omitempty"`
 }
 
 type TransferwiseQuoteTemporaryCreateParams struct {
@@ -3154,6 +3349,8 @@ type WhitelistSddOneOffUpdateParams struct {
 	MaximumAmountPerMonth *Amount `json:"maximum_amount_per_month,omitempty"`
 	MaximumAmountPerPayment *Amount `json:"maximum_amount_per_payment,omitempty"`
 	RoutingType string `json:"routing_type,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type WhitelistSddRecurring struct {
@@ -3185,6 +3382,8 @@ type WhitelistSddRecurringUpdateParams struct {
 	MaximumAmountPerMonth *Amount `json:"maximum_amount_per_month,omitempty"`
 	MaximumAmountPerPayment *Amount `json:"maximum_amount_per_payment,omitempty"`
 	RoutingType string `json:"routing_type,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type WhitelistSdd struct {
@@ -3245,7 +3444,7 @@ type MasterCardPayment struct {
 }
 
 type MasterCardIdentityCheckChallengeRequestUser struct {
-	Amount string `json:"amount,omitempty"`
+	Amount FlexFloat64 `json:"amount,omitempty"`
 	ExpiryTime string `json:"expiry_time,omitempty"`
 	Description string `json:"description,omitempty"`
 	Status string `json:"status,omitempty"`
@@ -3259,6 +3458,8 @@ type MasterCardIdentityCheckChallengeRequestUser struct {
 
 type MasterCardIdentityCheckChallengeRequestUserUpdateParams struct {
 	Status string `json:"status,omitempty"`
+	// ForceSendFields lists fields, by Go name, to send even when zero.
+	ForceSendFields []string `json:"-"`
 }
 
 type HealthCheck struct {
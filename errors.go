@@ -2,16 +2,42 @@ package bunq
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
 )
 
+// ErrNoResult is returned when bunq answers successfully with an empty
+// "Response" array, e.g. a lookup that matched nothing. Check for it with
+// errors.Is to tell it apart from a malformed response.
+var ErrNoResult = errors.New("bunq: no result in response")
+
+// ErrResponseTooLarge is returned when a response body exceeds
+// Config.MaxResponseBytes.
+var ErrResponseTooLarge = errors.New("bunq: response body too large")
+
+// ErrEnvironmentMismatch is returned by NewClient when a sandbox API key is
+// configured for the Production environment.
+var ErrEnvironmentMismatch = errors.New("bunq: sandbox API key used with production")
+
+// ErrUnexpectedContentType is returned when a downloaded file is not of the
+// requested format.
+var ErrUnexpectedContentType = errors.New("bunq: unexpected content type")
+
+// ErrLimitExceeded is returned by Limits.Check when a payment would exceed a
+// spending limit.
+var ErrLimitExceeded = errors.New("bunq: payment exceeds limit")
+
 // APIError represents an error response from the bunq API.
 type APIError struct {
 	StatusCode int
 	ResponseID string
 	Messages   []string
+
+	// translated holds bunq's error_description_translated values, which
+	// are meant for end users.
+	translated []string
 }
 
 func (e *APIError) Error() string {
@@ -19,7 +45,45 @@ func (e *APIError) Error() string {
 		e.StatusCode, e.ResponseID, strings.Join(e.Messages, "; "))
 }
 
-type BadRequestError struct{ APIError }
+// status is promoted to the error types that embed APIError, so statusCode
+// can find it on any of them.
+func (e *APIError) status() int { return e.StatusCode }
+
+// statusCode returns the HTTP status of the API error in err's chain, or 0 if
+// there is none, e.g. because bunq could not be reached.
+func statusCode(err error) int {
+	var apiErr interface{ status() int }
+	if errors.As(err, &apiErr) {
+		return apiErr.status()
+	}
+	return 0
+}
+
+// UserMessage returns a single message suitable for showing to end users:
+// bunq's translated description of the first error, falling back to the
+// plain description. Use Error for logs.
+func (e *APIError) UserMessage() string {
+	for _, msg := range e.translated {
+		if msg != "" {
+			return msg
+		}
+	}
+	for _, msg := range e.Messages {
+		if msg != "" {
+			return msg
+		}
+	}
+	return "unknown error"
+}
+
+// BadRequestError is returned for 400 responses. When bunq reports which
+// request fields failed validation, they are listed in FieldErrors; Messages
+// always holds the flat descriptions.
+type BadRequestError struct {
+	APIError
+	FieldErrors []FieldError
+}
+
 type UnauthorizedError struct{ APIError }
 type ForbiddenError struct{ APIError }
 type NotFoundError struct{ APIError }
@@ -27,20 +91,122 @@ type MethodNotAllowedError struct{ APIError }
 type TooManyRequestsError struct{ APIError }
 type InternalServerError struct{ APIError }
 
+// sessionExpiredMarkers are fragments of the error descriptions bunq returns
+// with a 401 when the session token has expired or was invalidated, as
+// opposed to the API key or IP address being rejected.
+var sessionExpiredMarkers = []string{
+	"insufficient authentication",
+	"authentication token",
+	"session",
+}
+
+// IsSessionExpired reports whether err is a 401 caused by an expired or
+// invalidated session. Opening a new session (e.g. creating a new Client)
+// fixes those; a 401 for which IsSessionExpired is false usually means the
+// API key was revoked or the IP address is not permitted.
+func IsSessionExpired(err error) bool {
+	var unauthorized *UnauthorizedError
+	if !errors.As(err, &unauthorized) {
+		return false
+	}
+	for _, msg := range unauthorized.Messages {
+		msg = strings.ToLower(msg)
+		for _, marker := range sessionExpiredMarkers {
+			if strings.Contains(msg, marker) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// InstallationError is returned by NewClient when bunq's installation response
+// cannot be used. Reason tells what was wrong; for InstallationBadServerKey,
+// Err holds the PEM parse error.
+type InstallationError struct {
+	Reason InstallationFailure
+	Err    error
+}
+
+// InstallationFailure classifies an InstallationError.
+type InstallationFailure int
+
+const (
+	InstallationNoToken InstallationFailure = iota + 1
+	InstallationNoServerKey
+	InstallationBadServerKey
+)
+
+func (e *InstallationError) Error() string {
+	switch e.Reason {
+	case InstallationNoToken:
+		return "no installation token in response"
+	case InstallationNoServerKey:
+		return "no server public key in response"
+	default:
+		return fmt.Sprintf("parsing server public key PEM: %v", e.Err)
+	}
+}
+
+func (e *InstallationError) Unwrap() error { return e.Err }
+
+// BootstrapError is returned by NewClient when a bootstrap step fails. The
+// steps before it may have left state at bunq that cannot be removed through
+// the API: a nonzero DeviceID means a device was registered for the API key,
+// which then shows up in the bunq app until it is removed there. An
+// installation alone registers no device. Callers retrying NewClient in a
+// loop accumulate such devices, one per attempt that got to device-server.
+type BootstrapError struct {
+	Step           string // the failed step, one of the BootstrapStep constants
+	InstallationID int
+	DeviceID       int
+	SessionID      int // nonzero if the session was opened; it expires by itself
+	Err            error
+
+	// DeviceAttempted is set once device-server was called. With a zero
+	// DeviceID, bunq may still have registered the device, e.g. if it
+	// answered but the response could not be parsed.
+	DeviceAttempted bool
+}
+
+func (e *BootstrapError) Error() string { return e.Err.Error() }
+
+func (e *BootstrapError) Unwrap() error { return e.Err }
+
+// DeviceRegistered reports whether the failed bootstrap is known to have left
+// a registered device behind: bunq returned its ID. See DeviceAttempted for
+// registrations whose outcome is unknown.
+func (e *BootstrapError) DeviceRegistered() bool { return e.DeviceID != 0 }
+
+// FieldError is a validation failure tied to a single request field.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
 // errorResponse is the JSON envelope for bunq error responses.
 type errorResponse struct {
 	Error []struct {
-		ErrorDescription string `json:"error_description"`
+		ErrorDescription           string `json:"error_description"`
+		ErrorDescriptionTranslated string `json:"error_description_translated"`
+		Field                      string `json:"field"`
 	} `json:"Error"`
 }
 
 func newAPIError(statusCode int, responseID string, body []byte) error {
 	var errResp errorResponse
 	messages := []string{"unknown error"}
+	var translated []string
+	var fieldErrors []FieldError
 	if err := json.Unmarshal(body, &errResp); err == nil && len(errResp.Error) > 0 {
 		messages = make([]string, len(errResp.Error))
+		translated = make([]string, len(errResp.Error))
 		for i, e := range errResp.Error {
 			messages[i] = e.ErrorDescription
+			translated[i] = e.ErrorDescriptionTranslated
+			if e.Field != "" {
+				fieldErrors = append(fieldErrors, FieldError{Field: e.Field, Message: e.ErrorDescription})
+			}
 		}
 	}
 
@@ -48,11 +214,12 @@ func newAPIError(statusCode int, responseID string, body []byte) error {
 		StatusCode: statusCode,
 		ResponseID: responseID,
 		Messages:   messages,
+		translated: translated,
 	}
 
 	switch statusCode {
 	case http.StatusBadRequest:
-		return &BadRequestError{base}
+		return &BadRequestError{APIError: base, FieldErrors: fieldErrors}
 	case http.StatusUnauthorized:
 		return &UnauthorizedError{base}
 	case http.StatusForbidden:
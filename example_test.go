@@ -0,0 +1,87 @@
+package bunq_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+
+	bunq "github.com/gwillem/bunq-go"
+)
+
+// exampleClient returns a client connected to a stub of the bunq API, so
+// examples run without credentials. Every call other than the bootstrap
+// succeeds with {"Id":{"id":1}}.
+func exampleClient() *bunq.Client {
+	serverKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		log.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&serverKey.PublicKey)
+	if err != nil {
+		log.Fatal(err)
+	}
+	serverPEM, _ := json.Marshal(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/installation":
+			fmt.Fprintf(w, `{"Response":[{"Id":{"id":1}},{"Token":{"token":"installation"}},{"ServerPublicKey":{"server_public_key":%s}}]}`, serverPEM)
+		case "/session-server":
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":1}},{"Token":{"token":"session"}},{"UserPerson":{"id":1}}]}`)
+		case "/user/1/monetary-account":
+			fmt.Fprint(w, `{"Response":[{"MonetaryAccountBank":{"id":1,"status":"ACTIVE"}}]}`)
+		default:
+			fmt.Fprint(w, `{"Response":[{"Id":{"id":1}}]}`)
+		}
+	}))
+
+	client, err := bunq.NewClient(context.Background(), bunq.Config{
+		APIKey:      "sandbox_example",
+		Environment: bunq.Environment{BaseURL: srv.URL},
+		HTTPClient:  srv.Client(),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	return client
+}
+
+// ptr returns a pointer to v, for the optional scalar fields of params in the
+// generated examples.
+func ptr[T any](v T) *T { return &v }
+
+func ExampleClient_PayIBAN() {
+	client := exampleClient()
+	id, err := client.PayIBAN(context.Background(), 0, "NL91 ABNA 0417 1643 00", "J. Doe", bunq.NewAmount(12.50, "EUR"), "invoice 42")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(id)
+	// Output: 1
+}
+
+func ExampleNewTestClient() {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/user/1/monetary-account/2/payment/7" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `{"Response":[{"Payment":{"id":7,"description":"lunch"}}]}`)
+	}))
+	defer srv.Close()
+
+	client := bunq.NewTestClient(srv.Client(), srv.URL)
+	payment, err := client.Payment.Get(context.Background(), 0, 7)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(payment.ID, payment.Description)
+	// Output: 7 lunch
+}
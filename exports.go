@@ -0,0 +1,46 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+)
+
+// The generated *ContentService types for exports decode JSON lists, but the
+// content endpoints return the exported file itself. The Download methods
+// here fetch it as raw bytes instead.
+
+// Download returns the PDF of a finished annual overview and its Content-Type.
+// Create the export first and wait until Get reports it as CREATED.
+func (s *ExportAnnualOverviewService) Download(ctx context.Context, exportAnnualOverviewID int) ([]byte, string, error) {
+	path := fmt.Sprintf("user/%d/export-annual-overview/%d/content", s.client.userID, exportAnnualOverviewID)
+	return s.client.download(ctx, path)
+}
+
+// Statement formats for ExportStatementCreateParams.StatementFormat.
+const (
+	StatementFormatPDF   = "PDF"
+	StatementFormatCSV   = "CSV"
+	StatementFormatMT940 = "MT940"
+)
+
+// statementMediaTypes maps statement formats to the media type bunq serves
+// them as.
+var statementMediaTypes = map[string]string{
+	StatementFormatPDF:   "application/pdf",
+	StatementFormatCSV:   "text/csv",
+	StatementFormatMT940: "application/x-mt940",
+}
+
+// Download returns the file of a finished customer statement, which must have
+// been created in format, one of the StatementFormat constants. Create the
+// statement first and wait until Get reports it as CREATED. If bunq returns
+// the file in another format, Download fails with ErrUnexpectedContentType.
+func (s *ExportStatementService) Download(ctx context.Context, monetaryAccountID, customerStatementID int, format string) ([]byte, error) {
+	mediaType, ok := statementMediaTypes[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown statement format %q", format)
+	}
+	path := fmt.Sprintf("user/%d/monetary-account/%d/customer-statement/%d/content",
+		s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), customerStatementID)
+	return s.client.downloadAs(ctx, path, mediaType)
+}
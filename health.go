@@ -0,0 +1,45 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Ping checks that the bunq API in env is reachable and healthy, without
+// credentials. It calls the unauthenticated health-check endpoint and returns
+// an error if bunq cannot be reached or does not answer 200 OK, e.g. a 503
+// during maintenance. Error responses are returned as the same types as other
+// API errors: *InternalServerError for a 500, *APIError for statuses without
+// a type of their own such as 503. Use errors.As to inspect them.
+//
+// Use it to tell "bunq is down" apart from "my credentials are wrong" when
+// NewClient fails. httpClient may be nil to use http.DefaultClient.
+func Ping(ctx context.Context, env Environment, httpClient *http.Client) error {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, env.BaseURL+"/health-check", nil)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	setDefaultHeaders(req)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("bunq unreachable: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Ping has no Config, so the body is capped at the default
+	// MaxResponseBytes.
+	body, err := readBody(resp.Body, defaultMaxResponseBytes)
+	if err != nil {
+		return fmt.Errorf("reading response body: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return newAPIError(resp.StatusCode, resp.Header.Get("X-Bunq-Client-Response-Id"), body)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package bunq
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+type idempotencyKeyKey struct{}
+
+// WithIdempotencyKey returns a context whose requests are sent with key as
+// their X-Bunq-Client-Request-Id, which bunq uses to recognize a request it
+// has already processed. key must be unique per operation, e.g. derived from
+// an order number, and reused only to repeat that operation.
+//
+// A POST, such as creating a payment, is only retried after a network error
+// or server error if it carries an idempotency key: without one, a retry
+// could pay twice when the first attempt did reach bunq.
+//
+// The key only applies to the request the call makes for the operation
+// itself. Other requests made along the way, such as opening a new session,
+// fetching the created object or polling a payment, get their own request
+// IDs.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyKey{}, key)
+}
+
+// requestID returns the X-Bunq-Client-Request-Id for a request made with ctx:
+// its idempotency key, or a fresh UUID.
+func requestID(ctx context.Context) string {
+	if key, ok := ctx.Value(idempotencyKeyKey{}).(string); ok && key != "" {
+		return key
+	}
+	return uuid.New().String()
+}
+
+// withoutIdempotencyKey returns ctx without its idempotency key, for requests
+// that are not the keyed operation itself.
+func withoutIdempotencyKey(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(idempotencyKeyKey{}).(string); !ok {
+		return ctx
+	}
+	return context.WithValue(ctx, idempotencyKeyKey{}, "")
+}
+
+// repeatable reports whether a request may be sent again after an attempt
+// that bunq may have processed. GET, PUT and DELETE are idempotent; other
+// methods need an idempotency key.
+func repeatable(ctx context.Context, method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	key, _ := ctx.Value(idempotencyKeyKey{}).(string)
+	return key != ""
+}
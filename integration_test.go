@@ -108,6 +108,27 @@ func TestIntegration(t *testing.T) {
 		})
 	})
 
+	t.Run("SendPayment", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+
+		payment, err := client.SendPayment(ctx, 0, PaymentCreateParams{
+			Amount: NewAmount(0.01, "EUR"),
+			CounterpartyAlias: &Pointer{
+				Type:  "EMAIL",
+				Value: "sugardaddy@bunq.com",
+			},
+			Description: "bunq-go send payment test",
+		})
+		if err != nil {
+			t.Fatalf("sending payment: %v", err)
+		}
+		if payment.Description != "bunq-go send payment test" {
+			t.Errorf("expected description 'bunq-go send payment test', got %q", payment.Description)
+		}
+		t.Logf("Sent payment %d: %s %s", payment.ID, payment.Amount.Value, payment.Amount.Currency)
+	})
+
 	t.Run("ListPayments", func(t *testing.T) {
 		count := 0
 		for p, err := range client.Payment.List(ctx, 0, &ListOptions{Count: 5}) {
@@ -147,3 +168,34 @@ func TestIntegration(t *testing.T) {
 		}
 	})
 }
+
+func TestIntegrationCompany(t *testing.T) {
+	ctx := context.Background()
+
+	apiKey, err := CreateSandboxCompanyAPIKey()
+	if err != nil {
+		t.Fatalf("creating sandbox company API key: %v", err)
+	}
+
+	client, err := NewClient(ctx, Config{
+		APIKey:      apiKey,
+		Environment: Sandbox,
+		Description: "bunq-go-integration-test",
+	})
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	if got := client.Bootstrap().UserType; got != "UserCompany" {
+		t.Errorf("expected UserCompany session, got %q", got)
+	}
+
+	profile, err := client.CurrentUser(ctx)
+	if err != nil {
+		t.Fatalf("getting current user: %v", err)
+	}
+	if profile.Type != "UserCompany" {
+		t.Errorf("expected user type UserCompany, got %q", profile.Type)
+	}
+	t.Logf("Company user %d (%s), primary account %d", profile.ID, profile.DisplayName, client.PrimaryMonetaryAccountID())
+}
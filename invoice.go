@@ -0,0 +1,23 @@
+package bunq
+
+// Items returns the lines of an invoice, across all of its groups. Groups
+// bundle the lines per product, e.g. the subscription or card orders.
+func (i *InvoiceByUser) Items() []*InvoiceItem {
+	return invoiceItems(i.Group)
+}
+
+// Items returns the lines of an invoice, across all of its groups. Groups
+// bundle the lines per product, e.g. the subscription or card orders.
+func (i *Invoice) Items() []*InvoiceItem {
+	return invoiceItems(i.Group)
+}
+
+func invoiceItems(groups []*InvoiceItemGroup) []*InvoiceItem {
+	var items []*InvoiceItem
+	for _, g := range groups {
+		if g != nil {
+			items = append(items, g.Item...)
+		}
+	}
+	return items
+}
@@ -0,0 +1,138 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+)
+
+// Limits holds the spending limits that apply to payments from a monetary
+// account. Check a payment against them before making it to avoid a 400.
+type Limits struct {
+	DailyLimit   *Amount // of the account; nil if it has none
+	DailySpent   *Amount // counted against DailyLimit; nil if not reported
+	MonthlyLimit *Amount // of the user, across accounts; nil if none
+	MonthlySpent *Amount // counted against MonthlyLimit
+
+	// Customer holds all user-wide limits, including the number of
+	// monetary accounts and cards the user may still open.
+	Customer *CustomerLimit
+}
+
+// Limits returns the spending limits for payments from a monetary account.
+func (c *Client) Limits(ctx context.Context, monetaryAccountID int) (*Limits, error) {
+	var l Limits
+	for cl, err := range c.CustomerLimit.List(ctx, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("reading customer limits: %w", err)
+		}
+		l.Customer = &cl
+		l.MonthlyLimit = cl.LimitAmountMonthly
+		l.MonthlySpent = cl.SpentAmountMonthly
+		break
+	}
+
+	a, err := c.MonetaryAccount.Get(ctx, monetaryAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("reading account limits: %w", err)
+	}
+	l.DailyLimit, l.DailySpent = dailyLimit(a.Object())
+	return &l, nil
+}
+
+// RemainingDailyLimit returns what is left of the daily limit today: the
+// limit minus what was spent, and never less than zero. Without a reported
+// DailySpent it is the whole limit. It returns nil if the account has no
+// daily limit.
+func (l *Limits) RemainingDailyLimit() (*Amount, error) {
+	if !isSet(l.DailyLimit) {
+		return nil, nil
+	}
+	if !isSet(l.DailySpent) {
+		return l.DailyLimit, nil
+	}
+	left, err := l.DailyLimit.Sub(l.DailySpent)
+	if err != nil {
+		return nil, err
+	}
+	units, err := left.MinorUnits()
+	if err != nil {
+		return nil, err
+	}
+	if units < 0 {
+		return NewAmount(0, left.Currency), nil
+	}
+	return left, nil
+}
+
+// Check returns an error wrapping ErrLimitExceeded if paying amount would
+// exceed what is left of the daily or monthly limit. If bunq does not report
+// what was spent today, it checks against the whole daily limit, so a
+// payment that passes may still be refused.
+func (l *Limits) Check(amount *Amount) error {
+	if isSet(l.DailyLimit) {
+		left, err := l.RemainingDailyLimit()
+		if err != nil {
+			return err
+		}
+		if err := checkWithin(amount, left, "remaining daily limit"); err != nil {
+			return err
+		}
+	}
+	if isSet(l.MonthlyLimit) {
+		left := l.MonthlyLimit
+		if isSet(l.MonthlySpent) {
+			var err error
+			if left, err = l.MonthlyLimit.Sub(l.MonthlySpent); err != nil {
+				return err
+			}
+		}
+		if err := checkWithin(amount, left, "remaining monthly limit"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isSet(a *Amount) bool {
+	return a != nil && a.Value != ""
+}
+
+func checkWithin(amount, limit *Amount, name string) error {
+	over, err := amount.Sub(limit)
+	if err != nil {
+		return err
+	}
+	units, err := over.MinorUnits()
+	if err != nil {
+		return err
+	}
+	if units > 0 {
+		return fmt.Errorf("%w: %s %s exceeds the %s of %s", ErrLimitExceeded, amount.Value, amount.Currency, name, limit.Value)
+	}
+	return nil
+}
+
+// dailyLimit returns the daily limit and what was spent against it of a
+// concrete monetary account such as *MonetaryAccountBank. Both are nil for
+// account types without a daily limit, such as MonetaryAccountSwitchService.
+func dailyLimit(account any) (limit, spent *Amount) {
+	switch a := account.(type) {
+	case *MonetaryAccountLight:
+		return a.DailyLimit, a.DailySpent
+	case *MonetaryAccountBank:
+		return a.DailyLimit, a.DailySpent
+	case *MonetaryAccountExternal:
+		return a.DailyLimit, a.DailySpent
+	case *MonetaryAccountInvestment:
+		return a.DailyLimit, a.DailySpent
+	case *MonetaryAccountJoint:
+		return a.DailyLimit, a.DailySpent
+	case *MonetaryAccountSavings:
+		return a.DailyLimit, a.DailySpent
+	case *MonetaryAccountExternalSavings:
+		return a.DailyLimit, a.DailySpent
+	case *MonetaryAccountCard:
+		return a.DailyLimit, a.DailySpent
+	}
+	return nil, nil
+}
@@ -0,0 +1,48 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// listFunc lists the items of one generated type, as ListAll does.
+type listFunc func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error]
+
+// ListableTypes returns the sorted names of the generated types ListAll can
+// list: those whose List method needs no ID other than, optionally, the
+// monetary account's.
+func ListableTypes() []string {
+	return slices.Sorted(maps.Keys(listRegistry))
+}
+
+// ListAll lists the items of the generated type typeName, e.g. "Payment",
+// for tools that handle every type alike, such as a full export. Each item
+// is the type's value, e.g. a Payment. monetaryAccountID is passed on to
+// account-scoped types, with 0 meaning the primary account, and ignored by
+// the others. Types not in ListableTypes yield an error.
+func (c *Client) ListAll(ctx context.Context, typeName string, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+	list, ok := listRegistry[typeName]
+	if !ok {
+		return errIter[any](fmt.Errorf("type %q cannot be listed by account", typeName))
+	}
+	return list(c, ctx, monetaryAccountID, opts)
+}
+
+// anySeq turns an iterator of T into one of any. Errors come with a nil
+// item rather than a zero T.
+func anySeq[T any](seq iter.Seq2[T, error]) iter.Seq2[any, error] {
+	return func(yield func(any, error) bool) {
+		for v, err := range seq {
+			var item any
+			if err == nil {
+				item = v
+			}
+			if !yield(item, err) {
+				return
+			}
+		}
+	}
+}
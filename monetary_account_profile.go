@@ -0,0 +1,67 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+)
+
+// Auto-savings rules are set through the monetary_account_profile field of a
+// bank account. The Python SDK only exposes that field when reading accounts,
+// so writing it is maintained by hand here.
+
+// MonetaryAccountProfileParams configures automatic top-ups and sweeps for a
+// monetary account. Leave a rule nil to keep its current setting.
+type MonetaryAccountProfileParams struct {
+	ProfileFill  *MonetaryAccountProfileFillParams  `json:"profile_fill,omitempty"`
+	ProfileDrain *MonetaryAccountProfileDrainParams `json:"profile_drain,omitempty"`
+}
+
+// MonetaryAccountProfileFillParams tops the account up to BalancePreferred
+// whenever its balance drops below BalanceThresholdLow.
+type MonetaryAccountProfileFillParams struct {
+	Status              string  `json:"status,omitempty"` // ACTIVE or DEACTIVATED
+	BalancePreferred    *Amount `json:"balance_preferred,omitempty"`
+	BalanceThresholdLow *Amount `json:"balance_threshold_low,omitempty"`
+	MethodFill          string  `json:"method_fill,omitempty"` // e.g. IDEAL
+	Issuer              *Issuer `json:"issuer,omitempty"`
+}
+
+// MonetaryAccountProfileDrainParams moves everything above BalancePreferred
+// to SavingsAccountAlias whenever the balance exceeds BalanceThresholdHigh.
+type MonetaryAccountProfileDrainParams struct {
+	Status               string   `json:"status,omitempty"` // ACTIVE or DEACTIVATED
+	BalancePreferred     *Amount  `json:"balance_preferred,omitempty"`
+	BalanceThresholdHigh *Amount  `json:"balance_threshold_high,omitempty"`
+	SavingsAccountAlias  *Pointer `json:"savings_account_alias,omitempty"`
+}
+
+type MonetaryAccountProfileService struct{ *service }
+
+// Get returns the auto-savings profile of a bank account, or nil if none is
+// configured.
+func (s *MonetaryAccountProfileService) Get(ctx context.Context, monetaryAccountID int) (*MonetaryAccountProfile, error) {
+	path := fmt.Sprintf("user/%d/monetary-account-bank/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
+	body, _, err := s.client.get(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	account, err := unmarshalObject[MonetaryAccountBank](body, "MonetaryAccountBank")
+	if err != nil {
+		return nil, err
+	}
+	return account.MonetaryAccountProfile, nil
+}
+
+// Update creates or changes the auto-savings profile of a bank account and
+// returns the account ID.
+func (s *MonetaryAccountProfileService) Update(ctx context.Context, monetaryAccountID int, params MonetaryAccountProfileParams) (int, error) {
+	path := fmt.Sprintf("user/%d/monetary-account-bank/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
+	reqBody := map[string]any{
+		"monetary_account_profile": params,
+	}
+	body, _, err := s.client.put(ctx, path, reqBody)
+	if err != nil {
+		return 0, err
+	}
+	return unmarshalID(body)
+}
@@ -0,0 +1,171 @@
+// Package mt940 parses SWIFT MT940 bank statements, such as the ones bunq
+// produces for statement exports in the MT940 format.
+package mt940
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	bunq "github.com/gwillem/bunq-go"
+)
+
+// StatementEntry is a single booked transaction (a :61: line and its :86:
+// information) from an MT940 statement.
+type StatementEntry struct {
+	AccountIBAN     string       // from :25:, without the currency suffix
+	ValueDate       time.Time    // date the amount is booked for interest
+	EntryDate       time.Time    // booking date; equals ValueDate when absent
+	Amount          *bunq.Amount // negative for debits
+	TransactionType string       // e.g. NTRF
+	Reference       string       // customer reference, often NONREF
+	BankReference   string       // reference after "//", if any
+	Supplementary   string       // second line of :61:, if any
+	Description     string       // :86: information to account owner
+}
+
+// statementLine matches the first line of a :61: field:
+// value date, optional entry date, debit/credit mark, optional funds code,
+// amount, transaction type, customer reference and optional bank reference.
+var statementLine = regexp.MustCompile(`^(\d{6})(\d{4})?(RC|RD|C|D)([A-Z])?(\d+,\d*)([NSF][A-Z0-9]{3})(.*?)(?://(.*))?$`)
+
+// field is a tagged MT940 field, e.g. tag "61" with its (possibly multi-line)
+// value.
+type field struct {
+	tag   string
+	lines []string
+}
+
+// Parse reads an MT940 statement and returns its entries in file order. A file
+// may contain several statements, each terminated by a line holding "-".
+func Parse(r io.Reader) ([]StatementEntry, error) {
+	fields, err := readFields(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []StatementEntry
+	var iban, currency string
+	for _, f := range fields {
+		switch f.tag {
+		case "25":
+			// Account identification: "NL02BUNQ0000000000 EUR" or "NL02BUNQ0000000000EUR".
+			iban = accountIBAN(strings.TrimSpace(f.lines[0]))
+		case "60F", "60M":
+			// Opening balance: mark, date, currency, amount, e.g. C240101EUR1000,00.
+			if len(f.lines[0]) < 10 {
+				return nil, fmt.Errorf("parsing :%s: %q: too short", f.tag, f.lines[0])
+			}
+			currency = f.lines[0][7:10]
+		case "61":
+			entry, err := parseStatementLine(f.lines)
+			if err != nil {
+				return nil, err
+			}
+			entry.AccountIBAN = iban
+			entry.Amount.Currency = currency
+			entries = append(entries, entry)
+		case "86":
+			if len(entries) == 0 {
+				continue // :86: may also describe the statement as a whole
+			}
+			entries[len(entries)-1].Description = strings.Join(f.lines, "")
+		}
+	}
+	return entries, nil
+}
+
+func readFields(r io.Reader) ([]field, error) {
+	var fields []field
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		switch {
+		case line == "-" || line == "":
+			continue
+		case strings.HasPrefix(line, ":"):
+			end := strings.Index(line[1:], ":")
+			if end < 0 {
+				return nil, fmt.Errorf("malformed field %q", line)
+			}
+			fields = append(fields, field{tag: line[1 : end+1], lines: []string{line[end+2:]}})
+		case len(fields) > 0:
+			last := &fields[len(fields)-1]
+			last.lines = append(last.lines, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading statement: %w", err)
+	}
+	return fields, nil
+}
+
+func parseStatementLine(lines []string) (StatementEntry, error) {
+	m := statementLine.FindStringSubmatch(lines[0])
+	if m == nil {
+		return StatementEntry{}, fmt.Errorf("parsing :61: %q: unrecognized format", lines[0])
+	}
+
+	valueDate, err := time.Parse("060102", m[1])
+	if err != nil {
+		return StatementEntry{}, fmt.Errorf("parsing :61: value date %q: %w", m[1], err)
+	}
+	entryDate := valueDate
+	if m[2] != "" {
+		entryDate, err = time.Parse("0102", m[2])
+		if err != nil {
+			return StatementEntry{}, fmt.Errorf("parsing :61: entry date %q: %w", m[2], err)
+		}
+		// The entry date has no year; take the value date's, adjusting for
+		// bookings that cross a year boundary.
+		year := valueDate.Year()
+		switch diff := int(entryDate.Month()) - int(valueDate.Month()); {
+		case diff > 6:
+			year--
+		case diff < -6:
+			year++
+		}
+		entryDate = entryDate.AddDate(year-entryDate.Year(), 0, 0)
+	}
+
+	whole, frac, _ := strings.Cut(m[5], ",")
+	for len(frac) < 2 {
+		frac += "0"
+	}
+	value := whole + "." + frac
+	if m[3] == "D" || m[3] == "RC" {
+		value = "-" + value
+	}
+
+	entry := StatementEntry{
+		ValueDate:       valueDate,
+		EntryDate:       entryDate,
+		Amount:          &bunq.Amount{Value: value},
+		TransactionType: m[6],
+		Reference:       m[7],
+		BankReference:   m[8],
+	}
+	if len(lines) > 1 {
+		entry.Supplementary = strings.Join(lines[1:], "")
+	}
+	return entry, nil
+}
+
+// accountIBAN returns the IBAN of a :25: account identification, without the
+// currency that follows it, with or without a space. The currency is only
+// stripped after a digit, which Dutch IBANs end in, so IBANs ending in
+// letters are kept whole.
+func accountIBAN(account string) string {
+	if i := strings.IndexByte(account, ' '); i >= 0 {
+		return account[:i]
+	}
+	n := len(account)
+	if n > 4 && account[n-4] >= '0' && account[n-4] <= '9' &&
+		strings.Trim(account[n-3:], "ABCDEFGHIJKLMNOPQRSTUVWXYZ") == "" {
+		return account[:n-3]
+	}
+	return account
+}
@@ -0,0 +1,86 @@
+package mt940
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	f, err := os.Open("testdata/statement.sta")
+	if err != nil {
+		t.Fatalf("opening sample: %v", err)
+	}
+	defer f.Close()
+
+	entries, err := Parse(f)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+
+	e := entries[0]
+	if e.AccountIBAN != "NL02BUNQ0000000000" {
+		t.Errorf("expected IBAN NL02BUNQ0000000000, got %s", e.AccountIBAN)
+	}
+	if !e.ValueDate.Equal(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected value date %v", e.ValueDate)
+	}
+	if !e.EntryDate.Equal(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected entry date %v", e.EntryDate)
+	}
+	if e.Amount.Value != "-12.50" || e.Amount.Currency != "EUR" {
+		t.Errorf("expected -12.50 EUR, got %s %s", e.Amount.Value, e.Amount.Currency)
+	}
+	if e.TransactionType != "NTRF" || e.Reference != "NONREF" || e.BankReference != "PAY-1001" {
+		t.Errorf("unexpected type/references: %q %q %q", e.TransactionType, e.Reference, e.BankReference)
+	}
+	if e.Supplementary != "lunch" {
+		t.Errorf("expected supplementary lunch, got %q", e.Supplementary)
+	}
+	if e.Description != "/TRTP/SEPA OVERBOEKING/IBAN/NL03BUNQ0000000001/NAME/Cafe de Hoek/REMI/Lunch" {
+		t.Errorf("unexpected description %q", e.Description)
+	}
+
+	e = entries[1]
+	if e.Amount.Value != "250.00" {
+		t.Errorf("expected 250.00, got %s", e.Amount.Value)
+	}
+	if e.Reference != "INV-2024-001" || e.BankReference != "" {
+		t.Errorf("unexpected references: %q %q", e.Reference, e.BankReference)
+	}
+	if !e.EntryDate.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected entry date %v", e.EntryDate)
+	}
+}
+
+func TestParse_EntryDateAcrossYearBoundary(t *testing.T) {
+	entries, err := Parse(strings.NewReader(":60F:C231229EUR0,00\n:61:2401011231D1,00NTRFNONREF\n-\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !entries[0].EntryDate.Equal(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected entry date 2023-12-31, got %v", entries[0].EntryDate)
+	}
+}
+
+func TestParse_AccountWithoutSpace(t *testing.T) {
+	for _, account := range []string{"NL02BUNQ0000000000 EUR", "NL02BUNQ0000000000EUR", "NL02BUNQ0000000000"} {
+		entries, err := Parse(strings.NewReader(":25:" + account + "\n:60F:C231229EUR0,00\n:61:2312291229D1,00NTRFNONREF\n-\n"))
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", account, err)
+		}
+		if got := entries[0].AccountIBAN; got != "NL02BUNQ0000000000" {
+			t.Errorf("%s: expected IBAN NL02BUNQ0000000000, got %s", account, got)
+		}
+	}
+}
+
+func TestParse_Malformed(t *testing.T) {
+	if _, err := Parse(strings.NewReader(":60F:C231229EUR0,00\n:61:garbage\n")); err == nil {
+		t.Error("expected error for malformed :61: line")
+	}
+}
@@ -0,0 +1,64 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+)
+
+// Notification delivery methods, for NotificationFilter.NotificationDeliveryMethod.
+const (
+	NotificationDeliveryMethodURL  = "URL"  // callback to NotificationTarget
+	NotificationDeliveryMethodPush = "PUSH" // push notification to the bunq app
+)
+
+// Notification categories, for NotificationFilter.Category.
+const (
+	NotificationCategoryBilling                   = "BILLING"
+	NotificationCategoryBunqMeTab                 = "BUNQME_TAB"
+	NotificationCategoryCardTransactionFailed     = "CARD_TRANSACTION_FAILED"
+	NotificationCategoryCardTransactionSuccessful = "CARD_TRANSACTION_SUCCESSFUL"
+	NotificationCategoryChat                      = "CHAT"
+	NotificationCategoryDraftPayment              = "DRAFT_PAYMENT"
+	NotificationCategoryIdeal                     = "IDEAL"
+	NotificationCategoryMutation                  = "MUTATION"
+	NotificationCategoryPayment                   = "PAYMENT"
+	NotificationCategoryRequest                   = "REQUEST"
+	NotificationCategoryScheduleResult            = "SCHEDULE_RESULT"
+	NotificationCategoryScheduleStatus            = "SCHEDULE_STATUS"
+	NotificationCategoryShare                     = "SHARE"
+	NotificationCategoryTabResult                 = "TAB_RESULT"
+)
+
+// Update replaces the user's push notification filters with one per
+// category. bunq has no separate update endpoint: creating filters replaces
+// the previous set, so calling Update without categories removes them all.
+func (s *NotificationFilterPushService) Update(ctx context.Context, categories ...string) (*NotificationFilterPush, error) {
+	// Not NotificationFilterPushCreateParams: its omitempty would drop an
+	// empty list, leaving the current filters in place.
+	params := struct {
+		NotificationFilters []*NotificationFilter `json:"notification_filters"`
+	}{NotificationFilters: []*NotificationFilter{}}
+	for _, c := range categories {
+		params.NotificationFilters = append(params.NotificationFilters, &NotificationFilter{Category: c})
+	}
+	path := fmt.Sprintf("user/%d/notification-filter-push", s.client.userID)
+	body, _, err := s.client.post(ctx, path, params)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalObject[NotificationFilterPush](body, "NotificationFilterPush")
+}
+
+// Categories returns the categories the user receives push notifications for.
+func (s *NotificationFilterPushService) Categories(ctx context.Context) ([]string, error) {
+	var categories []string
+	for f, err := range s.List(ctx, nil) {
+		if err != nil {
+			return nil, err
+		}
+		for _, nf := range f.NotificationFilters {
+			categories = append(categories, nf.Category)
+		}
+	}
+	return categories, nil
+}
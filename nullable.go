@@ -0,0 +1,84 @@
+package bunq
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Nullable is a request field with three states: unset, set to a value, or
+// cleared. Some bunq endpoints treat an explicit null differently from an
+// absent field, e.g. to clear a value on update. Tag Nullable fields with
+// omitzero so unset values are left out:
+//
+//	type params struct {
+//		Description bunq.NullableString `json:"description,omitzero"`
+//	}
+//
+// The zero value is unset. For generated params, which use plain fields,
+// see ForceSendFields instead.
+type Nullable[T any] struct {
+	value T
+	state nullableState
+}
+
+type nullableState uint8
+
+const (
+	nullableUnset nullableState = iota
+	nullableSet
+	nullableNull
+)
+
+// NullableString is a string that can be unset, set, or cleared.
+type NullableString = Nullable[string]
+
+// NullableAmount is an Amount that can be unset, set, or cleared.
+type NullableAmount = Nullable[*Amount]
+
+// NewNullable returns a Nullable set to v.
+func NewNullable[T any](v T) Nullable[T] {
+	return Nullable[T]{value: v, state: nullableSet}
+}
+
+// Null returns a cleared Nullable, which is sent as null.
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{state: nullableNull}
+}
+
+// Get returns the value and whether it is set.
+func (n Nullable[T]) Get() (T, bool) {
+	return n.value, n.state == nullableSet
+}
+
+// IsNull reports whether n is cleared.
+func (n Nullable[T]) IsNull() bool {
+	return n.state == nullableNull
+}
+
+// IsZero reports whether n is unset, so omitzero leaves it out.
+func (n Nullable[T]) IsZero() bool {
+	return n.state == nullableUnset
+}
+
+// MarshalJSON implements json.Marshaler. Cleared and unset values both encode
+// as null; use omitzero to omit unset ones.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.state != nullableSet {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. null decodes as cleared.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*n = Null[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = NewNullable(v)
+	return nil
+}
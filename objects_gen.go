@@ -211,39 +211,114 @@ type DraftPaymentEntry struct {
 }
 
 type DraftPaymentAnchorObject struct {
-	Payment string `json:"Payment,omitempty"`
-	PaymentBatch string `json:"PaymentBatch,omitempty"`
+	Payment *Payment `json:"Payment,omitempty"`
+	PaymentBatch *PaymentBatch `json:"PaymentBatch,omitempty"`
+}
+
+// Object returns the concrete object held by the DraftPaymentAnchorObject, or nil if none is set.
+func (a *DraftPaymentAnchorObject) Object() any {
+	switch {
+	case a.Payment != nil:
+		return a.Payment
+	case a.PaymentBatch != nil:
+		return a.PaymentBatch
+	}
+	return nil
 }
 
 type ScheduleAnchorObject struct {
-	Payment string `json:"Payment,omitempty"`
-	PaymentBatch string `json:"PaymentBatch,omitempty"`
+	Payment *Payment `json:"Payment,omitempty"`
+	PaymentBatch *PaymentBatch `json:"PaymentBatch,omitempty"`
+}
+
+// Object returns the concrete object held by the ScheduleAnchorObject, or nil if none is set.
+func (a *ScheduleAnchorObject) Object() any {
+	switch {
+	case a.Payment != nil:
+		return a.Payment
+	case a.PaymentBatch != nil:
+		return a.PaymentBatch
+	}
+	return nil
 }
 
 type EventObject struct {
-	BunqMeTab string `json:"BunqMeTab,omitempty"`
-	BunqMeTabResultResponse string `json:"BunqMeTabResultResponse,omitempty"`
-	BunqMeFundraiserResult string `json:"BunqMeFundraiserResult,omitempty"`
-	Card string `json:"Card,omitempty"`
-	CardDebit string `json:"CardDebit,omitempty"`
-	DraftPayment string `json:"DraftPayment,omitempty"`
-	FeatureAnnouncement string `json:"FeatureAnnouncement,omitempty"`
-	IdealMerchantTransaction string `json:"IdealMerchantTransaction,omitempty"`
-	Invoice string `json:"Invoice,omitempty"`
-	ScheduledPayment string `json:"ScheduledPayment,omitempty"`
-	ScheduledPaymentBatch string `json:"ScheduledPaymentBatch,omitempty"`
-	ScheduledInstance string `json:"ScheduledInstance,omitempty"`
-	MasterCardAction string `json:"MasterCardAction,omitempty"`
-	BankSwitchServiceNetherlandsIncomingPayment string `json:"BankSwitchServiceNetherlandsIncomingPayment,omitempty"`
-	Payment string `json:"Payment,omitempty"`
-	PaymentBatch string `json:"PaymentBatch,omitempty"`
-	RequestInquiryBatch string `json:"RequestInquiryBatch,omitempty"`
-	RequestInquiry string `json:"RequestInquiry,omitempty"`
-	RequestResponse string `json:"RequestResponse,omitempty"`
-	ShareInviteBankInquiry string `json:"ShareInviteBankInquiry,omitempty"`
-	ShareInviteBankResponse string `json:"ShareInviteBankResponse,omitempty"`
-	SofortMerchantTransaction string `json:"SofortMerchantTransaction,omitempty"`
-	TransferwisePayment string `json:"TransferwisePayment,omitempty"`
+	BunqMeTab *BunqMeTab `json:"BunqMeTab,omitempty"`
+	BunqMeTabResultResponse *BunqMeTabResultResponse `json:"BunqMeTabResultResponse,omitempty"`
+	BunqMeFundraiserResult *BunqMeFundraiserResult `json:"BunqMeFundraiserResult,omitempty"`
+	Card *Card `json:"Card,omitempty"`
+	CardDebit *CardDebit `json:"CardDebit,omitempty"`
+	DraftPayment *DraftPayment `json:"DraftPayment,omitempty"`
+	FeatureAnnouncement *FeatureAnnouncement `json:"FeatureAnnouncement,omitempty"`
+	IdealMerchantTransaction *IdealMerchantTransaction `json:"IdealMerchantTransaction,omitempty"`
+	Invoice *Invoice `json:"Invoice,omitempty"`
+	ScheduledPayment any `json:"ScheduledPayment,omitempty"`
+	ScheduledPaymentBatch any `json:"ScheduledPaymentBatch,omitempty"`
+	ScheduledInstance any `json:"ScheduledInstance,omitempty"`
+	MasterCardAction *MasterCardAction `json:"MasterCardAction,omitempty"`
+	BankSwitchServiceNetherlandsIncomingPayment *BankSwitchServiceNetherlandsIncomingPayment `json:"BankSwitchServiceNetherlandsIncomingPayment,omitempty"`
+	Payment *Payment `json:"Payment,omitempty"`
+	PaymentBatch *PaymentBatch `json:"PaymentBatch,omitempty"`
+	RequestInquiryBatch *RequestInquiryBatch `json:"RequestInquiryBatch,omitempty"`
+	RequestInquiry *RequestInquiry `json:"RequestInquiry,omitempty"`
+	RequestResponse *RequestResponse `json:"RequestResponse,omitempty"`
+	ShareInviteBankInquiry any `json:"ShareInviteBankInquiry,omitempty"`
+	ShareInviteBankResponse any `json:"ShareInviteBankResponse,omitempty"`
+	SofortMerchantTransaction *SofortMerchantTransaction `json:"SofortMerchantTransaction,omitempty"`
+	TransferwisePayment any `json:"TransferwisePayment,omitempty"`
+}
+
+// Object returns the concrete object held by the EventObject, or nil if none is set.
+func (a *EventObject) Object() any {
+	switch {
+	case a.BunqMeTab != nil:
+		return a.BunqMeTab
+	case a.BunqMeTabResultResponse != nil:
+		return a.BunqMeTabResultResponse
+	case a.BunqMeFundraiserResult != nil:
+		return a.BunqMeFundraiserResult
+	case a.Card != nil:
+		return a.Card
+	case a.CardDebit != nil:
+		return a.CardDebit
+	case a.DraftPayment != nil:
+		return a.DraftPayment
+	case a.FeatureAnnouncement != nil:
+		return a.FeatureAnnouncement
+	case a.IdealMerchantTransaction != nil:
+		return a.IdealMerchantTransaction
+	case a.Invoice != nil:
+		return a.Invoice
+	case a.ScheduledPayment != nil:
+		return a.ScheduledPayment
+	case a.ScheduledPaymentBatch != nil:
+		return a.ScheduledPaymentBatch
+	case a.ScheduledInstance != nil:
+		return a.ScheduledInstance
+	case a.MasterCardAction != nil:
+		return a.MasterCardAction
+	case a.BankSwitchServiceNetherlandsIncomingPayment != nil:
+		return a.BankSwitchServiceNetherlandsIncomingPayment
+	case a.Payment != nil:
+		return a.Payment
+	case a.PaymentBatch != nil:
+		return a.PaymentBatch
+	case a.RequestInquiryBatch != nil:
+		return a.RequestInquiryBatch
+	case a.RequestInquiry != nil:
+		return a.RequestInquiry
+	case a.RequestResponse != nil:
+		return a.RequestResponse
+	case a.ShareInviteBankInquiry != nil:
+		return a.ShareInviteBankInquiry
+	case a.ShareInviteBankResponse != nil:
+		return a.ShareInviteBankResponse
+	case a.SofortMerchantTransaction != nil:
+		return a.SofortMerchantTransaction
+	case a.TransferwisePayment != nil:
+		return a.TransferwisePayment
+	}
+	return nil
 }
 
 type SchedulePaymentEntry struct {
@@ -256,8 +331,19 @@ type SchedulePaymentEntry struct {
 }
 
 type ScheduleInstanceAnchorObject struct {
-	Payment string `json:"Payment,omitempty"`
-	PaymentBatch string `json:"PaymentBatch,omitempty"`
+	Payment *Payment `json:"Payment,omitempty"`
+	PaymentBatch *PaymentBatch `json:"PaymentBatch,omitempty"`
+}
+
+// Object returns the concrete object held by the ScheduleInstanceAnchorObject, or nil if none is set.
+func (a *ScheduleInstanceAnchorObject) Object() any {
+	switch {
+	case a.Payment != nil:
+		return a.Payment
+	case a.PaymentBatch != nil:
+		return a.PaymentBatch
+	}
+	return nil
 }
 
 type LabelCard struct {
@@ -286,16 +372,43 @@ type AttachmentMasterCardActionRefund struct {
 }
 
 type RequestReferenceSplitTheBillAnchorObject struct {
-	BillingInvoice string `json:"BillingInvoice,omitempty"`
-	DraftPayment string `json:"DraftPayment,omitempty"`
-	MasterCardAction string `json:"MasterCardAction,omitempty"`
-	Payment string `json:"Payment,omitempty"`
-	PaymentBatch string `json:"PaymentBatch,omitempty"`
-	RequestResponse string `json:"RequestResponse,omitempty"`
-	ScheduleInstance string `json:"ScheduleInstance,omitempty"`
-	WhitelistResult string `json:"WhitelistResult,omitempty"`
-	TransferwisePayment string `json:"TransferwisePayment,omitempty"`
-	CurrencyConversion string `json:"CurrencyConversion,omitempty"`
+	BillingInvoice any `json:"BillingInvoice,omitempty"`
+	DraftPayment *DraftPayment `json:"DraftPayment,omitempty"`
+	MasterCardAction *MasterCardAction `json:"MasterCardAction,omitempty"`
+	Payment *Payment `json:"Payment,omitempty"`
+	PaymentBatch *PaymentBatch `json:"PaymentBatch,omitempty"`
+	RequestResponse *RequestResponse `json:"RequestResponse,omitempty"`
+	ScheduleInstance *ScheduleInstance `json:"ScheduleInstance,omitempty"`
+	WhitelistResult *WhitelistResult `json:"WhitelistResult,omitempty"`
+	TransferwisePayment any `json:"TransferwisePayment,omitempty"`
+	CurrencyConversion *CurrencyConversion `json:"CurrencyConversion,omitempty"`
+}
+
+// Object returns the concrete object held by the RequestReferenceSplitTheBillAnchorObject, or nil if none is set.
+func (a *RequestReferenceSplitTheBillAnchorObject) Object() any {
+	switch {
+	case a.BillingInvoice != nil:
+		return a.BillingInvoice
+	case a.DraftPayment != nil:
+		return a.DraftPayment
+	case a.MasterCardAction != nil:
+		return a.MasterCardAction
+	case a.Payment != nil:
+		return a.Payment
+	case a.PaymentBatch != nil:
+		return a.PaymentBatch
+	case a.RequestResponse != nil:
+		return a.RequestResponse
+	case a.ScheduleInstance != nil:
+		return a.ScheduleInstance
+	case a.WhitelistResult != nil:
+		return a.WhitelistResult
+	case a.TransferwisePayment != nil:
+		return a.TransferwisePayment
+	case a.CurrencyConversion != nil:
+		return a.CurrencyConversion
+	}
+	return nil
 }
 
 type WhitelistResultViewAnchoredObject struct {
@@ -376,32 +489,92 @@ type NotificationUrl struct {
 }
 
 type NotificationAnchorObject struct {
-	BunqMeFundraiserResult string `json:"BunqMeFundraiserResult,omitempty"`
-	BunqMeTab string `json:"BunqMeTab,omitempty"`
-	BunqMeTabResultInquiry string `json:"BunqMeTabResultInquiry,omitempty"`
-	BunqMeTabResultResponse string `json:"BunqMeTabResultResponse,omitempty"`
-	ChatMessage string `json:"ChatMessage,omitempty"`
-	DraftPayment string `json:"DraftPayment,omitempty"`
-	IdealMerchantTransaction string `json:"IdealMerchantTransaction,omitempty"`
-	Invoice string `json:"Invoice,omitempty"`
-	MasterCardAction string `json:"MasterCardAction,omitempty"`
-	MonetaryAccount string `json:"MonetaryAccount,omitempty"`
-	Payment string `json:"Payment,omitempty"`
-	PaymentBatch string `json:"PaymentBatch,omitempty"`
-	RequestInquiry string `json:"RequestInquiry,omitempty"`
-	RequestInquiryBatch string `json:"RequestInquiryBatch,omitempty"`
-	RequestResponse string `json:"RequestResponse,omitempty"`
-	ShareInviteBankInquiry string `json:"ShareInviteBankInquiry,omitempty"`
-	ShareInviteBankResponse string `json:"ShareInviteBankResponse,omitempty"`
-	ScheduledPayment string `json:"ScheduledPayment,omitempty"`
-	ScheduledInstance string `json:"ScheduledInstance,omitempty"`
-	User string `json:"User,omitempty"`
+	BunqMeFundraiserResult *BunqMeFundraiserResult `json:"BunqMeFundraiserResult,omitempty"`
+	BunqMeTab *BunqMeTab `json:"BunqMeTab,omitempty"`
+	BunqMeTabResultInquiry *BunqMeTabResultInquiry `json:"BunqMeTabResultInquiry,omitempty"`
+	BunqMeTabResultResponse *BunqMeTabResultResponse `json:"BunqMeTabResultResponse,omitempty"`
+	ChatMessage *ChatMessage `json:"ChatMessage,omitempty"`
+	DraftPayment *DraftPayment `json:"DraftPayment,omitempty"`
+	IdealMerchantTransaction *IdealMerchantTransaction `json:"IdealMerchantTransaction,omitempty"`
+	Invoice *Invoice `json:"Invoice,omitempty"`
+	MasterCardAction *MasterCardAction `json:"MasterCardAction,omitempty"`
+	MonetaryAccount *MonetaryAccount `json:"MonetaryAccount,omitempty"`
+	Payment *Payment `json:"Payment,omitempty"`
+	PaymentBatch *PaymentBatch `json:"PaymentBatch,omitempty"`
+	RequestInquiry *RequestInquiry `json:"RequestInquiry,omitempty"`
+	RequestInquiryBatch *RequestInquiryBatch `json:"RequestInquiryBatch,omitempty"`
+	RequestResponse *RequestResponse `json:"RequestResponse,omitempty"`
+	ShareInviteBankInquiry any `json:"ShareInviteBankInquiry,omitempty"`
+	ShareInviteBankResponse any `json:"ShareInviteBankResponse,omitempty"`
+	ScheduledPayment any `json:"ScheduledPayment,omitempty"`
+	ScheduledInstance any `json:"ScheduledInstance,omitempty"`
+	User *User `json:"User,omitempty"`
+}
+
+// Object returns the concrete object held by the NotificationAnchorObject, or nil if none is set.
+func (a *NotificationAnchorObject) Object() any {
+	switch {
+	case a.BunqMeFundraiserResult != nil:
+		return a.BunqMeFundraiserResult
+	case a.BunqMeTab != nil:
+		return a.BunqMeTab
+	case a.BunqMeTabResultInquiry != nil:
+		return a.BunqMeTabResultInquiry
+	case a.BunqMeTabResultResponse != nil:
+		return a.BunqMeTabResultResponse
+	case a.ChatMessage != nil:
+		return a.ChatMessage
+	case a.DraftPayment != nil:
+		return a.DraftPayment
+	case a.IdealMerchantTransaction != nil:
+		return a.IdealMerchantTransaction
+	case a.Invoice != nil:
+		return a.Invoice
+	case a.MasterCardAction != nil:
+		return a.MasterCardAction
+	case a.MonetaryAccount != nil:
+		return a.MonetaryAccount
+	case a.Payment != nil:
+		return a.Payment
+	case a.PaymentBatch != nil:
+		return a.PaymentBatch
+	case a.RequestInquiry != nil:
+		return a.RequestInquiry
+	case a.RequestInquiryBatch != nil:
+		return a.RequestInquiryBatch
+	case a.RequestResponse != nil:
+		return a.RequestResponse
+	case a.ShareInviteBankInquiry != nil:
+		return a.ShareInviteBankInquiry
+	case a.ShareInviteBankResponse != nil:
+		return a.ShareInviteBankResponse
+	case a.ScheduledPayment != nil:
+		return a.ScheduledPayment
+	case a.ScheduledInstance != nil:
+		return a.ScheduledInstance
+	case a.User != nil:
+		return a.User
+	}
+	return nil
 }
 
 type UserApiKeyAnchoredUser struct {
-	UserPerson string `json:"UserPerson,omitempty"`
-	UserCompany string `json:"UserCompany,omitempty"`
-	UserPaymentServiceProvider string `json:"UserPaymentServiceProvider,omitempty"`
+	UserPerson *UserPerson `json:"UserPerson,omitempty"`
+	UserCompany *UserCompany `json:"UserCompany,omitempty"`
+	UserPaymentServiceProvider *UserPaymentServiceProvider `json:"UserPaymentServiceProvider,omitempty"`
+}
+
+// Object returns the concrete object held by the UserApiKeyAnchoredUser, or nil if none is set.
+func (a *UserApiKeyAnchoredUser) Object() any {
+	switch {
+	case a.UserPerson != nil:
+		return a.UserPerson
+	case a.UserCompany != nil:
+		return a.UserCompany
+	case a.UserPaymentServiceProvider != nil:
+		return a.UserPaymentServiceProvider
+	}
+	return nil
 }
 
 type PermittedDevice struct {
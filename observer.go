@@ -0,0 +1,70 @@
+package bunq
+
+import (
+	"context"
+	"time"
+)
+
+// RequestEvent describes one HTTP attempt made by the client. Retries of the
+// same call are reported as separate events with increasing Attempt.
+type RequestEvent struct {
+	Method     string
+	Path       string // relative to the base URL, including the query string
+	Attempt    int    // zero-based
+	StatusCode int    // 0 if the request could not be executed
+	Duration   time.Duration
+	Err        error  // transport or read error; API errors are only in StatusCode
+	Tag        string // set with WithRequestTag
+}
+
+// Observer is called after every HTTP attempt, e.g. to record metrics. It is
+// called synchronously, so it should return quickly.
+type Observer func(RequestEvent)
+
+// Bootstrap steps, as reported in BootstrapEvent.Step.
+const (
+	BootstrapStepInstallation   = "installation"
+	BootstrapStepDeviceServer   = "device-server"
+	BootstrapStepSessionServer  = "session-server"
+	BootstrapStepPrimaryAccount = "primary-account"
+	BootstrapStepPSD2Credential = "psd2-credential" // NewPSD2Client only
+)
+
+// BootstrapEvent describes one step of setting up a client in NewClient or
+// NewClientFromPythonContext. The HTTP attempts a step makes are reported to
+// the Observer as well.
+type BootstrapEvent struct {
+	Step     string // one of the BootstrapStep constants
+	Duration time.Duration
+	Err      error // nil if the step succeeded
+}
+
+// BootstrapObserver is called after every bootstrap step, e.g. to log slow or
+// failed bootstraps.
+type BootstrapObserver func(BootstrapEvent)
+
+// bootstrapStep runs one bootstrap step, reports it to the
+// BootstrapObserver, if any, and returns how long it took.
+func (c *Client) bootstrapStep(step string, fn func() error) (time.Duration, error) {
+	start := time.Now()
+	err := fn()
+	d := time.Since(start)
+	if c.cfg.BootstrapObserver != nil {
+		c.cfg.BootstrapObserver(BootstrapEvent{Step: step, Duration: d, Err: err})
+	}
+	return d, err
+}
+
+type requestTagKey struct{}
+
+// WithRequestTag returns a context whose requests are reported to the
+// Observer with the given tag, so rate limit usage can be attributed to the
+// feature that made the calls.
+func WithRequestTag(ctx context.Context, tag string) context.Context {
+	return context.WithValue(ctx, requestTagKey{}, tag)
+}
+
+func requestTag(ctx context.Context) string {
+	tag, _ := ctx.Value(requestTagKey{}).(string)
+	return tag
+}
@@ -0,0 +1,57 @@
+package bunq
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// bunq allows 3 GET requests per 3 seconds (POST and PUT limits are similar)
+// and blocks the client for 30 seconds after a 429.
+const (
+	rateLimitRequests = 3
+	rateLimitWindow   = 3 * time.Second
+	rateLimitCooldown = 30 * time.Second
+)
+
+// requestLog remembers the start times of the most recent requests and the
+// last 429, to suggest how long to wait before the next request.
+type requestLog struct {
+	mu      sync.Mutex
+	times   [rateLimitRequests]time.Time // ring buffer
+	next    int
+	last429 time.Time
+}
+
+func (l *requestLog) record(start time.Time, status int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.times[l.next] = start
+	l.next = (l.next + 1) % len(l.times)
+	if status == http.StatusTooManyRequests {
+		l.last429 = start
+	}
+}
+
+func (l *requestLog) suggestedDelay(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var delay time.Duration
+	if !l.last429.IsZero() {
+		delay = l.last429.Add(rateLimitCooldown).Sub(now)
+	}
+	// The oldest entry is the one next would overwrite. If it is still
+	// inside the window, the next request would be one too many.
+	if oldest := l.times[l.next]; !oldest.IsZero() {
+		delay = max(delay, oldest.Add(rateLimitWindow).Sub(now))
+	}
+	return max(delay, 0)
+}
+
+// SuggestedDelay returns how long to wait before the next request to stay
+// within bunq's rate limits, based on the requests this client made recently.
+// It is a hint for pacing work; the client does not enforce it.
+func (c *Client) SuggestedDelay() time.Duration {
+	return c.requests.suggestedDelay(time.Now())
+}
@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"iter"
 	"net/url"
+	"reflect"
 	"strconv"
+	"sync"
+	"time"
 )
 
 // Pagination holds cursor information returned by list endpoints.
@@ -63,20 +66,34 @@ type listResponse[T any] struct {
 // hitting rate limits (3 GET calls per 3 seconds).
 const defaultListCount = 200
 
+// errIter returns an iterator that yields only err.
+func errIter[T any](err error) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}
+}
+
 // listIter returns an iterator that automatically paginates through all items.
 func listIter[T any](c *Client, ctx context.Context, path, key string, opts *ListOptions) iter.Seq2[T, error] {
 	return func(yield func(T, error) bool) {
-		count := defaultListCount
-		if opts != nil && opts.Count > 0 {
-			count = opts.Count
+		if err := opts.validate(key); err != nil {
+			var zero T
+			yield(zero, fmt.Errorf("listing %s: %w", key, err))
+			return
 		}
-		if opts == nil {
-			opts = &ListOptions{}
+		// Copy opts so the caller's value is never written to; it may be
+		// shared between goroutines.
+		var o ListOptions
+		if opts != nil {
+			o = *opts
 		}
-		if opts.Count == 0 {
-			opts.Count = count
+		if o.Count == 0 {
+			o.Count = defaultListCount
 		}
-		params := opts.toParams()
+		count := o.Count
+		stopBefore := o.StopBefore
+		params := o.toParams()
 		prevOlderID := 0
 		for {
 			body, _, err := c.get(ctx, path, params)
@@ -85,26 +102,129 @@ func listIter[T any](c *Client, ctx context.Context, path, key string, opts *Lis
 				yield(zero, fmt.Errorf("listing %s: %w", key, err))
 				return
 			}
-			resp, err := unmarshalList[T](body, key)
+			// Items are decoded one at a time, so a page of large objects
+			// is never held in memory all at once.
+			n, stopped := 0, false
+			pagination, err := decodeList(body, key, func(item T) bool {
+				n++
+				if !stopBefore.IsZero() {
+					if created, ok := createdAt(item); ok && created.Before(stopBefore) {
+						stopped = true
+						return false
+					}
+				}
+				if !yield(item, nil) {
+					stopped = true
+					return false
+				}
+				return true
+			})
+			if stopped {
+				return
+			}
 			if err != nil {
 				var zero T
 				yield(zero, fmt.Errorf("unmarshaling %s list: %w", key, err))
 				return
 			}
-			if len(resp.Items) == 0 {
+			if n == 0 {
 				return
 			}
-			for _, item := range resp.Items {
-				if !yield(item, nil) {
-					return
-				}
-			}
-			olderID, ok := resp.Pagination.olderID()
+			olderID, ok := pagination.olderID()
 			if !ok || olderID == prevOlderID {
 				return
 			}
 			prevOlderID = olderID
-			params = (&ListOptions{OlderID: olderID, Count: count}).toParams()
+			params = (&ListOptions{OlderID: olderID, Count: count, Filters: o.Filters, Flags: o.Flags}).toParams()
+		}
+	}
+}
+
+// AccountScopedItem is an item yielded by ListAcrossAccounts, tagged with the
+// monetary account it was listed from.
+type AccountScopedItem[T any] struct {
+	MonetaryAccountID int
+	Item              T
+}
+
+// ListAcrossAccounts lists items from several monetary accounts at once, with
+// at most concurrency accounts being paged through at the same time. list is
+// called once per account, e.g.
+//
+//	func(ctx context.Context, id int) iter.Seq2[bunq.Payment, error] {
+//		return client.Payment.List(ctx, id, nil)
+//	}
+//
+// Items from different accounts are interleaved in arrival order. An error
+// for one account is yielded with that account's ID and does not stop the
+// others. All requests share the client's 429 handling, so keep concurrency
+// low (bunq allows 3 GET requests per 3 seconds).
+func ListAcrossAccounts[T any](ctx context.Context, monetaryAccountIDs []int, concurrency int, list func(ctx context.Context, monetaryAccountID int) iter.Seq2[T, error]) iter.Seq2[AccountScopedItem[T], error] {
+	return func(yield func(AccountScopedItem[T], error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		type result struct {
+			item AccountScopedItem[T]
+			err  error
+		}
+		results := make(chan result)
+		sem := make(chan struct{}, max(concurrency, 1))
+		var wg sync.WaitGroup
+
+		for _, id := range monetaryAccountIDs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				select {
+				case sem <- struct{}{}:
+					defer func() { <-sem }()
+				case <-ctx.Done():
+					return
+				}
+				for item, err := range list(ctx, id) {
+					select {
+					case results <- result{AccountScopedItem[T]{id, item}, err}:
+					case <-ctx.Done():
+						return
+					}
+					if err != nil {
+						return
+					}
+				}
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		for r := range results {
+			if !yield(r.item, r.err) {
+				return
+			}
 		}
 	}
 }
+
+// bunqTimeLayout is the format of timestamps such as created and updated.
+// bunq returns them in UTC.
+const bunqTimeLayout = "2006-01-02 15:04:05.999999"
+
+// createdAt returns the parsed Created field of a generated object, if it
+// has one.
+func createdAt(item any) (time.Time, bool) {
+	v := reflect.Indirect(reflect.ValueOf(item))
+	if v.Kind() != reflect.Struct {
+		return time.Time{}, false
+	}
+	f := v.FieldByName("Created")
+	if !f.IsValid() || f.Kind() != reflect.String {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(bunqTimeLayout, f.String())
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,55 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+)
+
+// Auto-allocate rule types.
+const (
+	PaymentAutoAllocateTypeAmount     = "AMOUNT"     // each target gets a fixed Amount
+	PaymentAutoAllocateTypePercentage = "PERCENTAGE" // each target gets a Fraction
+)
+
+// PaymentAutoAllocateTarget is one target of an auto-allocate rule, as sent
+// when creating it. The generated PaymentAutoAllocateDefinition is the
+// response shape, which names the counterparty with a LabelMonetaryAccount
+// rather than a Pointer.
+type PaymentAutoAllocateTarget struct {
+	CounterpartyAlias *Pointer `json:"counterparty_alias"`
+	Description       string   `json:"description,omitempty"`
+	Amount            *Amount  `json:"amount,omitempty"`   // for PaymentAutoAllocateTypeAmount
+	Fraction          float64  `json:"fraction,omitempty"` // for PaymentAutoAllocateTypePercentage, e.g. 0.25
+}
+
+// PaymentAutoAllocateRuleParams holds the fields for creating an
+// auto-allocate rule. PaymentID is an earlier incoming payment that serves as
+// the template: later payments from the same counterparty are allocated.
+type PaymentAutoAllocateRuleParams struct {
+	PaymentID  int                         `json:"payment_id"`
+	Type       string                      `json:"type"`
+	Definition []PaymentAutoAllocateTarget `json:"definition"`
+}
+
+// CreateRule creates a rule that automatically passes on incoming payments
+// to the given targets and returns its ID. Allocations made by the rule are
+// listed with PaymentAutoAllocateInstance.List.
+func (s *PaymentAutoAllocateService) CreateRule(ctx context.Context, monetaryAccountID int, params PaymentAutoAllocateRuleParams) (int, error) {
+	if len(params.Definition) == 0 {
+		return 0, fmt.Errorf("auto-allocate rule needs at least one target")
+	}
+	for _, t := range params.Definition {
+		if t.CounterpartyAlias == nil {
+			return 0, fmt.Errorf("auto-allocate target %q has no counterparty", t.Description)
+		}
+		if err := t.CounterpartyAlias.Validate(); err != nil {
+			return 0, err
+		}
+	}
+	path := fmt.Sprintf("user/%d/monetary-account/%d/payment-auto-allocate", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
+	body, _, err := s.client.post(ctx, path, params)
+	if err != nil {
+		return 0, err
+	}
+	return unmarshalID(body)
+}
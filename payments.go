@@ -0,0 +1,145 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// paymentPollInterval is the delay between Get calls while waiting for a
+// payment to settle. One GET per second stays within bunq's rate limit of
+// 3 GET calls per 3 seconds.
+const paymentPollInterval = time.Second
+
+// SendPayment creates a payment and polls it until it has settled or ctx is
+// done, returning the settled Payment.
+//
+// Payments between bunq accounts settle immediately, so usually the first Get
+// already returns the final state. Payments sent to an email address or phone
+// number of a non-bunq user (bunq.to) stay pending until the recipient
+// claims them; use a context deadline to bound the wait.
+//
+// If the payment was created but waiting for it fails, the error is returned
+// together with the last Payment seen, or a Payment holding only the ID if
+// none was fetched yet, so the caller can look it up later.
+func (c *Client) SendPayment(ctx context.Context, monetaryAccountID int, params PaymentCreateParams) (*Payment, error) {
+	id, err := c.Payment.Create(ctx, monetaryAccountID, params)
+	if err != nil {
+		return nil, fmt.Errorf("creating payment: %w", err)
+	}
+
+	// An idempotency key on ctx is for the POST only.
+	pollCtx := withoutIdempotencyKey(ctx)
+	payment := &Payment{ID: id}
+	err = c.pollUntil(ctx, paymentPollInterval, func() (bool, error) {
+		p, err := c.Payment.Get(pollCtx, monetaryAccountID, id)
+		if err != nil {
+			return false, err
+		}
+		payment = p
+		return paymentSettled(p), nil
+	})
+	if err != nil {
+		return payment, fmt.Errorf("waiting for payment %d to settle: %w", id, err)
+	}
+	return payment, nil
+}
+
+// validate makes Payment.Create fail before sending a counterparty that bunq
+// would reject, such as an IBAN pointer without a name.
+func (p PaymentCreateParams) validate() error {
+	if p.CounterpartyAlias == nil {
+		return nil
+	}
+	return p.CounterpartyAlias.Validate()
+}
+
+// PayIBAN pays amount to the bank account iban, held by name, and returns the
+// payment ID. The IBAN is validated before anything is sent.
+func (c *Client) PayIBAN(ctx context.Context, monetaryAccountID int, iban, name string, amount *Amount, description string) (int, error) {
+	counterparty, err := NewIBANPointer(iban, name)
+	if err != nil {
+		return 0, err
+	}
+	return c.Payment.Create(ctx, monetaryAccountID, PaymentCreateParams{
+		Amount:            amount,
+		CounterpartyAlias: counterparty,
+		Description:       description,
+	})
+}
+
+// PaymentRefundParams holds the fields for refunding a received payment.
+// Amount may be less than the original payment for a partial refund.
+type PaymentRefundParams struct {
+	Amount      *Amount `json:"amount,omitempty"`
+	Description string  `json:"description,omitempty"`
+}
+
+// Refund refunds a received payment back to its sender and returns the ID of
+// the refund. Unlike creating a new payment, the refund is linked to the
+// original payment and needs no counterparty.
+func (s *PaymentService) Refund(ctx context.Context, monetaryAccountID int, paymentID int, params PaymentRefundParams) (int, error) {
+	path := fmt.Sprintf("user/%d/monetary-account/%d/payment/%d/refund", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentID)
+	body, _, err := s.client.post(ctx, path, params)
+	if err != nil {
+		return 0, err
+	}
+	return unmarshalID(body)
+}
+
+// Location returns the coordinates where a payment was made, such as a card
+// payment at a terminal. ok is false if bunq did not record a location, which
+// it reports as missing or as 0,0.
+func (p *Payment) Location() (latitude, longitude float64, ok bool) {
+	g := p.Geolocation
+	if g == nil || (g.Latitude == 0 && g.Longitude == 0) {
+		return 0, 0, false
+	}
+	return float64(g.Latitude), float64(g.Longitude), true
+}
+
+// ChatAllowed reports whether a chat can be started about the payment. It is
+// false if bunq did not say.
+func (p *Payment) ChatAllowed() bool {
+	return p.AllowChat != nil && *p.AllowChat
+}
+
+// AdditionalTransactionInformation is the enrichment bunq adds to a payment,
+// such as its spending category and, for card payments, the merchant. The
+// Python SDK does not declare it, so it is maintained by hand.
+type AdditionalTransactionInformation struct {
+	Category             string       `json:"category,omitempty"` // as in AdditionalTransactionInformationCategory.Category
+	MerchantCategoryCode string       `json:"merchant_category_code,omitempty"`
+	MerchantName         string       `json:"merchant_name,omitempty"`
+	Geolocation          *Geolocation `json:"geolocation,omitempty"`
+}
+
+// Category returns the payment's spending category, e.g. "GROCERIES", or ""
+// if bunq did not categorize it.
+func (p *Payment) Category() string {
+	if p.AdditionalTransactionInformation == nil {
+		return ""
+	}
+	return p.AdditionalTransactionInformation.Category
+}
+
+// paymentSettled reports whether a payment is no longer waiting for a bunq.to
+// recipient to claim it. Only BunqtoStatus is checked, as payments between
+// bunq accounts are settled once they are created.
+func paymentSettled(p *Payment) bool {
+	return p.BunqtoStatus != "PENDING"
+}
+
+// pollUntil calls check until it reports done, returns an error, or ctx is
+// done, sleeping interval between calls on the client's clock.
+func (c *Client) pollUntil(ctx context.Context, interval time.Duration, check func() (bool, error)) error {
+	for {
+		done, err := check()
+		if err != nil || done {
+			return err
+		}
+		if err := c.sleep(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
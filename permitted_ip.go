@@ -0,0 +1,19 @@
+package bunq
+
+import "context"
+
+// The IPs allowed to use an API key are set once with device-server and can
+// be managed afterwards through the key's credential-password-ip. List the
+// credentials with UserCredentialPasswordIp.List to find its ID.
+
+// Add allows ip to use the credential and returns the ID of the new entry.
+// Keep the ID to remove the IP later; bunq does not return it when listing.
+func (s *PermittedIpService) Add(ctx context.Context, credentialPasswordIPID int, ip string) (int, error) {
+	return s.Create(ctx, credentialPasswordIPID, PermittedIpCreateParams{IP: ip, Status: "ACTIVE"})
+}
+
+// Remove deactivates a permitted IP. bunq has no delete for permitted IPs.
+func (s *PermittedIpService) Remove(ctx context.Context, credentialPasswordIPID int, ipID int) error {
+	_, err := s.Update(ctx, credentialPasswordIPID, ipID, PermittedIpUpdateParams{Status: "INACTIVE"})
+	return err
+}
@@ -0,0 +1,39 @@
+package bunq
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+)
+
+// TransportWithPinnedCert returns an HTTP transport that only trusts server
+// certificates chaining up to one of the given PEM-encoded certificates,
+// instead of the system root pool. Use it to pin bunq's TLS certificate:
+//
+//	transport, err := bunq.TransportWithPinnedCert([][]byte{caPEM})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	client, err := bunq.NewClient(ctx, bunq.Config{
+//		APIKey:     apiKey,
+//		HTTPClient: &http.Client{Transport: transport},
+//	})
+func TransportWithPinnedCert(pemCerts [][]byte) (*http.Transport, error) {
+	if len(pemCerts) == 0 {
+		return nil, fmt.Errorf("no certificates to pin")
+	}
+	pool := x509.NewCertPool()
+	for i, pemCert := range pemCerts {
+		if !pool.AppendCertsFromPEM(pemCert) {
+			return nil, fmt.Errorf("parsing pinned certificate %d: no valid PEM certificate found", i)
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:    pool,
+		MinVersion: tls.VersionTLS12,
+	}
+	return transport, nil
+}
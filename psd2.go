@@ -0,0 +1,123 @@
+package bunq
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// PSD2Certificate is the eIDAS certificate of a licensed payment service
+// provider (PSP), as issued by a qualified trust service provider.
+//
+// Only PSPs with a PSD2 license from their national regulator, registered
+// with bunq as such, can use it; regular users and companies authenticate
+// with an API key instead. In the sandbox, bunq accepts self-signed
+// certificates.
+type PSD2Certificate struct {
+	Certificate string          // PEM-encoded certificate
+	Chain       []string        // PEM-encoded intermediate and root certificates
+	PrivateKey  *rsa.PrivateKey // the certificate's private key
+}
+
+// NewPSD2Client creates a client for a payment service provider. Instead of
+// an API key, which cfg must not have, it registers cert with bunq and
+// authenticates with the credential bunq returns for it. The resulting
+// client acts as a UserPaymentServiceProvider, which has no monetary
+// accounts of its own: use it to manage OAuth clients and to access the
+// accounts of users who granted the PSP access.
+func NewPSD2Client(ctx context.Context, cfg Config, cert PSD2Certificate) (*Client, error) {
+	if cfg.APIKey != "" {
+		return nil, fmt.Errorf("PSD2 clients authenticate with a certificate, not an API key")
+	}
+	if cert.Certificate == "" || cert.PrivateKey == nil {
+		return nil, fmt.Errorf("PSD2 certificate needs a certificate and its private key")
+	}
+	c := newClient(cfg)
+
+	privateKey, err := generateRSAKeyPair()
+	if err != nil {
+		return nil, fmt.Errorf("generating RSA key pair: %w", err)
+	}
+	c.privateKey = privateKey
+
+	if c.bootstrap.InstallationTime, err = c.bootstrapStep(BootstrapStepInstallation, func() (err error) {
+		c.bootstrap.InstallationID, err = c.doInstallation(ctx)
+		return err
+	}); err != nil {
+		return nil, c.bootstrapError(BootstrapStepInstallation, fmt.Errorf("installation: %w", err))
+	}
+
+	var credential *PaymentServiceProviderCredential
+	if _, err = c.bootstrapStep(BootstrapStepPSD2Credential, func() (err error) {
+		credential, err = c.doPSD2Credential(ctx, cert)
+		return err
+	}); err != nil {
+		return nil, c.bootstrapError(BootstrapStepPSD2Credential, fmt.Errorf("psd2 credential: %w", err))
+	}
+	// The credential's token takes the place of the API key from here on,
+	// including when the session is refreshed.
+	c.cfg.APIKey = credential.TokenValue
+
+	if c.bootstrap.DeviceTime, err = c.bootstrapStep(BootstrapStepDeviceServer, func() (err error) {
+		c.bootstrap.DeviceID, err = c.doDeviceServer(ctx)
+		return err
+	}); err != nil {
+		return nil, c.bootstrapError(BootstrapStepDeviceServer, fmt.Errorf("device-server: %w", err))
+	}
+
+	if c.bootstrap.SessionTime, err = c.bootstrapStep(BootstrapStepSessionServer, func() (err error) {
+		c.bootstrap.SessionID, c.bootstrap.UserType, err = c.doSessionServer(ctx)
+		return err
+	}); err != nil {
+		return nil, c.bootstrapError(BootstrapStepSessionServer, fmt.Errorf("session-server: %w", err))
+	}
+	c.bootstrap.UserID = c.userID
+
+	c.initServices()
+	c.initCustomServices()
+
+	return c, nil
+}
+
+// doPSD2Credential registers the PSP certificate for the installation's key
+// and returns the credential, whose token serves as the API key.
+func (c *Client) doPSD2Credential(ctx context.Context, cert PSD2Certificate) (*PaymentServiceProviderCredential, error) {
+	params, err := newPSD2CredentialParams(&c.privateKey.PublicKey, cert)
+	if err != nil {
+		return nil, err
+	}
+	// Sent with the installation token: there is no session yet.
+	body, _, err := c.request(ctx, http.MethodPost, "payment-service-provider-credential", params, false)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := unmarshalObject[PaymentServiceProviderCredential](body, "CredentialPasswordIp")
+	if err != nil {
+		return nil, err
+	}
+	if credential.TokenValue == "" {
+		return nil, fmt.Errorf("no token in credential response")
+	}
+	return credential, nil
+}
+
+// newPSD2CredentialParams builds the request registering cert. The PSP
+// proves it holds the certificate by signing the client's public key, as
+// sent in the installation, followed by the certificate.
+func newPSD2CredentialParams(clientKey *rsa.PublicKey, cert PSD2Certificate) (PaymentServiceProviderCredentialCreateParams, error) {
+	sig, err := signRequest(cert.PrivateKey, []byte(publicKeyToPEM(clientKey)+cert.Certificate))
+	if err != nil {
+		return PaymentServiceProviderCredentialCreateParams{}, err
+	}
+	chain := make([]string, len(cert.Chain))
+	for i, pem := range cert.Chain {
+		chain[i] = strings.TrimSpace(pem)
+	}
+	return PaymentServiceProviderCredentialCreateParams{
+		ClientPaymentServiceProviderCertificate:      cert.Certificate,
+		ClientPaymentServiceProviderCertificateChain: strings.Join(chain, "\n"),
+		ClientPublicKeySignature:                     sig,
+	}, nil
+}
@@ -0,0 +1,183 @@
+package bunq
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"time"
+)
+
+// pythonContext mirrors the API context JSON written by the Python SDK's
+// ApiContext.save().
+type pythonContext struct {
+	EnvironmentType     string                     `json:"environment_type"`
+	APIKey              string                     `json:"api_key"`
+	SessionContext      *pythonSessionContext      `json:"session_context"`
+	InstallationContext *pythonInstallationContext `json:"installation_context"`
+	ProxyURL            *string                    `json:"proxy_url"`
+}
+
+type pythonSessionContext struct {
+	Token      string `json:"token"`
+	ExpiryTime string `json:"expiry_time"`
+	UserID     int    `json:"user_id"`
+}
+
+type pythonInstallationContext struct {
+	Token            string `json:"token"`
+	PrivateKeyClient string `json:"private_key_client"`
+	PublicKeyClient  string `json:"public_key_client"`
+	PublicKeyServer  string `json:"public_key_server"`
+}
+
+// pythonTimeLayout is how the Python SDK serializes datetimes. The SDK uses
+// naive local times and parses them with %f, so the microseconds are always
+// written, even when zero. Parsing uses time.DateTime, which also accepts
+// times written without them.
+const pythonTimeLayout = "2006-01-02 15:04:05.000000"
+
+// NewClientFromPythonContext creates a client from an API context file saved by
+// the Python SDK, reusing its installation and device registration instead of
+// bootstrapping a new one. If the saved session has expired, a new session is
+// opened with the saved installation.
+//
+// cfg.APIKey and cfg.Environment default to the values in the context file;
+// the other fields behave as for NewClient. If cfg.APIKey differs from the
+// saved key, a device is registered for it on the saved installation (or the
+// device registered for it earlier is reused) and the saved session, which
+// belongs to the other key, is discarded.
+func NewClientFromPythonContext(ctx context.Context, path string, cfg Config) (*Client, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading python context: %w", err)
+	}
+
+	var pc pythonContext
+	if err := json.Unmarshal(data, &pc); err != nil {
+		return nil, fmt.Errorf("parsing python context: %w", err)
+	}
+	if pc.InstallationContext == nil {
+		return nil, fmt.Errorf("python context has no installation_context")
+	}
+
+	newKey := cfg.APIKey != "" && cfg.APIKey != pc.APIKey
+	if cfg.APIKey == "" {
+		cfg.APIKey = pc.APIKey
+	}
+	if cfg.Environment.BaseURL == "" {
+		switch pc.EnvironmentType {
+		case "PRODUCTION":
+			cfg.Environment = Production
+		case "SANDBOX":
+			cfg.Environment = Sandbox
+		default:
+			return nil, fmt.Errorf("unknown environment_type %q in python context", pc.EnvironmentType)
+		}
+	}
+	if err := checkEnvironment(cfg); err != nil {
+		return nil, err
+	}
+
+	c := newClient(cfg)
+
+	c.privateKey, err = parsePrivateKeyPEM(pc.InstallationContext.PrivateKeyClient)
+	if err != nil {
+		return nil, fmt.Errorf("parsing private_key_client: %w", err)
+	}
+	c.serverPublicKey, err = parsePublicKeyPEM(pc.InstallationContext.PublicKeyServer)
+	if err != nil {
+		return nil, fmt.Errorf("parsing public_key_server: %w", err)
+	}
+	c.installationToken = pc.InstallationContext.Token
+	if c.installationToken == "" {
+		return nil, fmt.Errorf("python context has no installation token")
+	}
+
+	if newKey {
+		if c.bootstrap.DeviceTime, err = c.bootstrapStep(BootstrapStepDeviceServer, func() (err error) {
+			c.bootstrap.DeviceID, err = c.doDeviceServerReused(ctx)
+			return err
+		}); err != nil {
+			return nil, fmt.Errorf("device-server: %w", err)
+		}
+	} else if sc := pc.SessionContext; sc != nil && sc.Token != "" {
+		expiry, err := time.ParseInLocation(time.DateTime, sc.ExpiryTime, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("parsing session expiry_time: %w", err)
+		}
+		c.sessionToken = sc.Token
+		c.sessionExpiry = expiry
+		c.userID = sc.UserID
+	}
+
+	// An expired or missing session is replaced by a fresh one, which also
+	// tells us the user ID.
+	if c.userID == 0 || c.sessionExpiry.Sub(c.now()) <= c.sessionRefreshThreshold() {
+		if c.bootstrap.SessionTime, err = c.bootstrapStep(BootstrapStepSessionServer, func() (err error) {
+			c.bootstrap.SessionID, c.bootstrap.UserType, err = c.doSessionServer(ctx)
+			return err
+		}); err != nil {
+			return nil, fmt.Errorf("session-server: %w", err)
+		}
+	}
+
+	if c.bootstrap.AccountTime, err = c.bootstrapStep(BootstrapStepPrimaryAccount, func() error {
+		return c.findPrimaryAccount(ctx)
+	}); err != nil {
+		return nil, fmt.Errorf("finding primary account: %w", err)
+	}
+	c.bootstrap.UserID = c.userID
+	c.bootstrap.PrimaryMonetaryAccountID = c.primaryMonetaryAccountID
+
+	c.initServices()
+	c.initCustomServices()
+
+	return c, nil
+}
+
+// ExportPythonContext serializes the client's installation and session in
+// the Python SDK's API context format, so the file can be loaded with
+// ApiContext.restore() or NewClientFromPythonContext.
+//
+// Environments other than Production are exported as SANDBOX.
+func (c *Client) ExportPythonContext() ([]byte, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.privateKey == nil || c.serverPublicKey == nil || c.installationToken == "" {
+		return nil, fmt.Errorf("client has no installation to export")
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(c.privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling private key: %w", err)
+	}
+
+	envType := "SANDBOX"
+	if c.cfg.Environment == Production {
+		envType = "PRODUCTION"
+	}
+
+	pc := pythonContext{
+		EnvironmentType: envType,
+		APIKey:          c.cfg.APIKey,
+		InstallationContext: &pythonInstallationContext{
+			Token:            c.installationToken,
+			PrivateKeyClient: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+			PublicKeyClient:  publicKeyToPEM(&c.privateKey.PublicKey),
+			PublicKeyServer:  publicKeyToPEM(c.serverPublicKey),
+		},
+	}
+	if c.sessionToken != "" {
+		pc.SessionContext = &pythonSessionContext{
+			Token:      c.sessionToken,
+			ExpiryTime: c.sessionExpiry.In(time.Local).Format(pythonTimeLayout),
+			UserID:     c.userID,
+		}
+	}
+
+	return json.MarshalIndent(pc, "", "  ")
+}
@@ -0,0 +1,63 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+)
+
+// QRRef identifies a resource whose QR code can be fetched with
+// QRService.Content. Use TabQR, CashRegisterQR or RequestInquiryQR.
+type QRRef interface {
+	qrContentPath(c *Client) (string, error)
+}
+
+// TabQR refers to the QR code of a Tab on a cash register.
+type TabQR struct {
+	MonetaryAccountID int // 0 for the primary account
+	CashRegisterID    int
+	TabUUID           string
+}
+
+func (r TabQR) qrContentPath(c *Client) (string, error) {
+	if err := validateUUID("TabUUID", r.TabUUID); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("user/%d/monetary-account/%d/cash-register/%d/tab/%s/qr-code-content",
+		c.userID, c.resolveMonetaryAccountID(r.MonetaryAccountID), r.CashRegisterID, r.TabUUID), nil
+}
+
+// CashRegisterQR refers to a QR code registered on a cash register.
+type CashRegisterQR struct {
+	MonetaryAccountID int // 0 for the primary account
+	CashRegisterID    int
+	QRCodeID          int
+}
+
+func (r CashRegisterQR) qrContentPath(c *Client) (string, error) {
+	return fmt.Sprintf("user/%d/monetary-account/%d/cash-register/%d/qr-code/%d/content",
+		c.userID, c.resolveMonetaryAccountID(r.MonetaryAccountID), r.CashRegisterID, r.QRCodeID), nil
+}
+
+// RequestInquiryQR refers to the QR code of a payment request, which the
+// counterparty can scan to pay it.
+type RequestInquiryQR struct {
+	MonetaryAccountID int // 0 for the primary account
+	RequestInquiryID  int
+}
+
+func (r RequestInquiryQR) qrContentPath(c *Client) (string, error) {
+	return fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry/%d/qr-code-content",
+		c.userID, c.resolveMonetaryAccountID(r.MonetaryAccountID), r.RequestInquiryID), nil
+}
+
+type QRService struct{ *service }
+
+// Content downloads the QR code image for ref, returning the image bytes and
+// their Content-Type (normally image/png).
+func (s *QRService) Content(ctx context.Context, ref QRRef) ([]byte, string, error) {
+	path, err := ref.qrContentPath(s.client)
+	if err != nil {
+		return nil, "", err
+	}
+	return s.client.download(ctx, path)
+}
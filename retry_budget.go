@@ -0,0 +1,32 @@
+package bunq
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+)
+
+// ErrRetryBudgetExhausted is returned, wrapping the last error, when a request
+// would have been retried but the context's retry budget is used up.
+var ErrRetryBudgetExhausted = errors.New("bunq: retry budget exhausted")
+
+type retryBudgetKey struct{}
+
+// WithRetryBudget returns a context that allows at most n retries in total
+// across all requests made with it, including every page of a List
+// iteration. Without a budget, each request retries independently.
+func WithRetryBudget(ctx context.Context, n int) context.Context {
+	budget := new(atomic.Int64)
+	budget.Store(int64(n))
+	return context.WithValue(ctx, retryBudgetKey{}, budget)
+}
+
+// takeRetry consumes one retry from ctx's budget. It reports false if the
+// budget is used up; contexts without a budget always allow the retry.
+func takeRetry(ctx context.Context) bool {
+	budget, ok := ctx.Value(retryBudgetKey{}).(*atomic.Int64)
+	if !ok {
+		return true
+	}
+	return budget.Add(-1) >= 0
+}
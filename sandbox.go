@@ -11,20 +11,23 @@ import (
 // CreateSandboxAPIKey creates a new sandbox user and returns its API key.
 // This calls the sandbox API directly without authentication.
 func CreateSandboxAPIKey() (string, error) {
-	url := Sandbox.BaseURL + "/sandbox-user-person"
+	return createSandboxUser("sandbox-user-person")
+}
+
+// CreateSandboxCompanyAPIKey is like CreateSandboxAPIKey, but creates a
+// business user, for testing UserCompany flows.
+func CreateSandboxCompanyAPIKey() (string, error) {
+	return createSandboxUser("sandbox-user-company")
+}
+
+func createSandboxUser(endpoint string) (string, error) {
+	url := Sandbox.BaseURL + "/" + endpoint
 
 	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader([]byte("{}")))
 	if err != nil {
 		return "", fmt.Errorf("creating request: %w", err)
 	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("User-Agent", userAgent)
-	req.Header.Set("X-Bunq-Client-Request-Id", "sandbox-setup")
-	req.Header.Set("X-Bunq-Geolocation", "0 0 0 0 NL")
-	req.Header.Set("X-Bunq-Language", "en_US")
-	req.Header.Set("X-Bunq-Region", "nl_NL")
-	req.Header.Set("Cache-Control", "no-cache")
+	setDefaultHeaders(req)
 
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
@@ -43,7 +46,7 @@ func CreateSandboxAPIKey() (string, error) {
 
 	// Response: {"Response":[{"ApiKey":{"api_key":"..."}}]}
 	var envelope struct {
-		Response []json.RawMessage `json:"Response"`
+		Response responseItems `json:"Response"`
 	}
 	if err := json.Unmarshal(body, &envelope); err != nil {
 		return "", fmt.Errorf("parsing response: %w", err)
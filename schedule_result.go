@@ -0,0 +1,79 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// Scheduled payment result states.
+const (
+	ScheduleResultFinished        = "FINISHED_SUCCESSFULLY"
+	ScheduleResultRetry           = "RETRY"
+	ScheduleResultFailedUserError = "FAILED_USER_ERROR"
+)
+
+// ScheduledPaymentResult is one execution of a scheduled payment: the
+// payment bunq made, or why it failed. It is the schedule-instance endpoint's
+// response with the result typed, which the generated ScheduleInstance
+// leaves as any.
+type ScheduledPaymentResult struct {
+	ID                int                           `json:"id,omitempty"`
+	Created           string                        `json:"created,omitempty"`
+	Updated           string                        `json:"updated,omitempty"`
+	SchedulePaymentID int                           `json:"-"`
+	State             string                        `json:"state,omitempty"`
+	TimeStart         string                        `json:"time_start,omitempty"`
+	TimeEnd           string                        `json:"time_end,omitempty"`
+	ErrorMessage      []*Error                      `json:"error_message,omitempty"`
+	ResultObject      *ScheduleInstanceAnchorObject `json:"result_object,omitempty"`
+}
+
+// Payment returns the payment made by a scheduled payment, or nil if the
+// execution failed or made a batch.
+func (r *ScheduledPaymentResult) Payment() *Payment {
+	if r.ResultObject == nil {
+		return nil
+	}
+	return r.ResultObject.Payment
+}
+
+type ScheduledPaymentResultService struct{ *service }
+
+// List returns the executions of a scheduled payment, newest first.
+func (s *ScheduledPaymentResultService) List(ctx context.Context, monetaryAccountID int, schedulePaymentID int, opts *ListOptions) iter.Seq2[ScheduledPaymentResult, error] {
+	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule/%d/schedule-instance", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), schedulePaymentID)
+	return func(yield func(ScheduledPaymentResult, error) bool) {
+		for r, err := range listIter[ScheduledPaymentResult](s.client, ctx, path, "ScheduledInstance", opts) {
+			r.SchedulePaymentID = schedulePaymentID
+			if !yield(r, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// ListAccount returns the executions of all scheduled payments of a monetary
+// account, grouped by scheduled payment. It makes a request per scheduled
+// payment.
+func (s *ScheduledPaymentResultService) ListAccount(ctx context.Context, monetaryAccountID int) iter.Seq2[ScheduledPaymentResult, error] {
+	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-payment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
+	return func(yield func(ScheduledPaymentResult, error) bool) {
+		// The generated SchedulePayment has no ID, which is all that is
+		// needed here.
+		type schedulePaymentID struct {
+			ID int `json:"id"`
+		}
+		for sp, err := range listIter[schedulePaymentID](s.client, ctx, path, "ScheduledPayment", nil) {
+			if err != nil {
+				yield(ScheduledPaymentResult{}, err)
+				return
+			}
+			for r, err := range s.List(ctx, monetaryAccountID, sp.ID, nil) {
+				if !yield(r, err) || err != nil {
+					return
+				}
+			}
+		}
+	}
+}
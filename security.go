@@ -1,14 +1,20 @@
 package bunq
 
 import (
+	"bytes"
 	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha1"
 	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/pem"
 	"fmt"
+	"net/http"
 )
 
 func generateRSAKeyPair() (*rsa.PrivateKey, error) {
@@ -42,6 +48,43 @@ func verifyResponse(serverPubKey *rsa.PublicKey, body []byte, signature string)
 	return rsa.VerifyPKCS1v15(serverPubKey, crypto.SHA256, h[:], sig)
 }
 
+// encryptRequest encrypts body for the X-Bunq-Client-Encryption scheme:
+// AES-256-CBC with PKCS#7 padding under a fresh key, that key encrypted with
+// the server's public key, and an HMAC-SHA1 over the IV and ciphertext. It
+// returns the ciphertext and the headers to send with it.
+func encryptRequest(serverPubKey *rsa.PublicKey, body []byte) ([]byte, http.Header, error) {
+	key := make([]byte, 32)
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, nil, fmt.Errorf("generating encryption key: %w", err)
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return nil, nil, fmt.Errorf("generating encryption IV: %w", err)
+	}
+	encKey, err := rsa.EncryptPKCS1v15(rand.Reader, serverPubKey, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encrypting request key: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	pad := aes.BlockSize - len(body)%aes.BlockSize
+	ciphertext := append(bytes.Clone(body), bytes.Repeat([]byte{byte(pad)}, pad)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, ciphertext)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(iv)
+	mac.Write(ciphertext)
+
+	h := http.Header{}
+	h.Set("X-Bunq-Client-Encryption-Key", base64.StdEncoding.EncodeToString(encKey))
+	h.Set("X-Bunq-Client-Encryption-Iv", base64.StdEncoding.EncodeToString(iv))
+	h.Set("X-Bunq-Client-Encryption-Hmac", base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+	return ciphertext, h, nil
+}
+
 func parsePublicKeyPEM(pemStr string) (*rsa.PublicKey, error) {
 	block, _ := pem.Decode([]byte(pemStr))
 	if block == nil {
@@ -62,3 +105,24 @@ func parsePublicKeyPEM(pemStr string) (*rsa.PublicKey, error) {
 	}
 	return pub, nil
 }
+
+func parsePrivateKeyPEM(pemStr string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(pemStr))
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode PEM block")
+	}
+
+	// Try PKCS1 first, then PKCS8
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	keyInterface, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key: %w", err)
+	}
+	key, ok := keyInterface.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("not an RSA private key")
+	}
+	return key, nil
+}
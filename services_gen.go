@@ -42,13 +42,21 @@ func (s *InvoiceExportPdfService) Get(ctx context.Context, invoiceID int, invoic
 	return unmarshalObject[InvoiceExportPdf](body, "InvoiceExportPdf")
 }
 
-func (s *InvoiceExportPdfService) Update(ctx context.Context, invoiceID int, invoiceExportID int) (int, error) {
+func (s *InvoiceExportPdfService) CreateAndFetch(ctx context.Context, invoiceID int) (*InvoiceExportPdf, error) {
+	id, err := s.Create(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), invoiceID, id)
+}
+
+func (s *InvoiceExportPdfService) Update(ctx context.Context, invoiceID int, invoiceExportID int) (*UpdateResult[InvoiceExportPdf], error) {
 	path := fmt.Sprintf("user/%d/invoice/%d/invoice-export/%d", s.client.userID, invoiceID, invoiceExportID)
 	body, _, err := s.client.put(ctx, path, nil)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[InvoiceExportPdf](body, "InvoiceExportPdf")
 }
 
 func (s *InvoiceExportPdfService) Delete(ctx context.Context, invoiceID int, invoiceExportID int) error {
@@ -130,6 +138,9 @@ func (s *AttachmentMonetaryAccountContentService) List(ctx context.Context, mone
 type AttachmentPublicContentService struct{ *service }
 
 func (s *AttachmentPublicContentService) List(ctx context.Context, attachmentPublicID string, opts *ListOptions) iter.Seq2[AttachmentPublicContent, error] {
+	if err := validateUUID("attachmentPublicID", attachmentPublicID); err != nil {
+		return errIter[AttachmentPublicContent](err)
+	}
 	path := fmt.Sprintf("attachment-public/%s/content", attachmentPublicID)
 	return listIter[AttachmentPublicContent](s.client, ctx, path, "AttachmentPublicContent", opts)
 }
@@ -164,6 +175,9 @@ func (s *AttachmentPublicService) Create(ctx context.Context) (string, error) {
 }
 
 func (s *AttachmentPublicService) Get(ctx context.Context, attachmentPublicID string) (*AttachmentPublic, error) {
+	if err := validateUUID("attachmentPublicID", attachmentPublicID); err != nil {
+		return nil, err
+	}
 	path := fmt.Sprintf("attachment-public/%s", attachmentPublicID)
 	body, _, err := s.client.get(ctx, path, nil)
 	if err != nil {
@@ -194,8 +208,11 @@ func (s *AvatarService) Create(ctx context.Context, params AvatarCreateParams) (
 	return unmarshalUUID(body)
 }
 
-func (s *AvatarService) Get(ctx context.Context, avatarID int) (*Avatar, error) {
-	path := fmt.Sprintf("avatar/%d", avatarID)
+func (s *AvatarService) Get(ctx context.Context, avatarID string) (*Avatar, error) {
+	if err := validateUUID("avatarID", avatarID); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("avatar/%s", avatarID)
 	body, _, err := s.client.get(ctx, path, nil)
 	if err != nil {
 		return nil, err
@@ -234,6 +251,14 @@ func (s *PaymentService) Get(ctx context.Context, monetaryAccountID int, payment
 	return unmarshalObject[Payment](body, "Payment")
 }
 
+func (s *PaymentService) CreateAndFetch(ctx context.Context, monetaryAccountID int, params PaymentCreateParams) (*Payment, error) {
+	id, err := s.Create(ctx, monetaryAccountID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, id)
+}
+
 func (s *PaymentService) List(ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[Payment, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
 	return listIter[Payment](s.client, ctx, path, "Payment", opts)
@@ -275,18 +300,26 @@ func (s *PaymentBatchService) Get(ctx context.Context, monetaryAccountID int, pa
 	return unmarshalObject[PaymentBatch](body, "PaymentBatch")
 }
 
+func (s *PaymentBatchService) CreateAndFetch(ctx context.Context, monetaryAccountID int, params PaymentBatchCreateParams) (*PaymentBatch, error) {
+	id, err := s.Create(ctx, monetaryAccountID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, id)
+}
+
 func (s *PaymentBatchService) List(ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[PaymentBatch, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment-batch", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
 	return listIter[PaymentBatch](s.client, ctx, path, "PaymentBatch", opts)
 }
 
-func (s *PaymentBatchService) Update(ctx context.Context, monetaryAccountID int, paymentBatchID int, params PaymentBatchUpdateParams) (int, error) {
+func (s *PaymentBatchService) Update(ctx context.Context, monetaryAccountID int, paymentBatchID int, params PaymentBatchUpdateParams) (*UpdateResult[PaymentBatch], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment-batch/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentBatchID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[PaymentBatch](body, "PaymentBatch")
 }
 
 type BunqMeFundraiserProfileUserService struct{ *service }
@@ -347,18 +380,26 @@ func (s *BunqMeTabService) Get(ctx context.Context, monetaryAccountID int, bunqm
 	return unmarshalObject[BunqMeTab](body, "BunqMeTab")
 }
 
+func (s *BunqMeTabService) CreateAndFetch(ctx context.Context, monetaryAccountID int, params BunqMeTabCreateParams) (*BunqMeTab, error) {
+	id, err := s.Create(ctx, monetaryAccountID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, id)
+}
+
 func (s *BunqMeTabService) List(ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[BunqMeTab, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/bunqme-tab", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
 	return listIter[BunqMeTab](s.client, ctx, path, "BunqMeTab", opts)
 }
 
-func (s *BunqMeTabService) Update(ctx context.Context, monetaryAccountID int, bunqmeTabID int, params BunqMeTabUpdateParams) (int, error) {
+func (s *BunqMeTabService) Update(ctx context.Context, monetaryAccountID int, bunqmeTabID int, params BunqMeTabUpdateParams) (*UpdateResult[BunqMeTab], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/bunqme-tab/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), bunqmeTabID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[BunqMeTab](body, "BunqMeTab")
 }
 
 type CardBatchReplaceService struct{ *service }
@@ -414,18 +455,26 @@ func (s *CardGeneratedCvc2Service) Get(ctx context.Context, cardID int, generate
 	return unmarshalObject[CardGeneratedCvc2](body, "CardGeneratedCvc2")
 }
 
+func (s *CardGeneratedCvc2Service) CreateAndFetch(ctx context.Context, cardID int, params CardGeneratedCvc2CreateParams) (*CardGeneratedCvc2, error) {
+	id, err := s.Create(ctx, cardID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), cardID, id)
+}
+
 func (s *CardGeneratedCvc2Service) List(ctx context.Context, cardID int, opts *ListOptions) iter.Seq2[CardGeneratedCvc2, error] {
 	path := fmt.Sprintf("user/%d/card/%d/generated-cvc2", s.client.userID, cardID)
 	return listIter[CardGeneratedCvc2](s.client, ctx, path, "CardGeneratedCvc2", opts)
 }
 
-func (s *CardGeneratedCvc2Service) Update(ctx context.Context, cardID int, generatedCVC2ID int, params CardGeneratedCvc2UpdateParams) (int, error) {
+func (s *CardGeneratedCvc2Service) Update(ctx context.Context, cardID int, generatedCVC2ID int, params CardGeneratedCvc2UpdateParams) (*UpdateResult[CardGeneratedCvc2], error) {
 	path := fmt.Sprintf("user/%d/card/%d/generated-cvc2/%d", s.client.userID, cardID, generatedCVC2ID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[CardGeneratedCvc2](body, "CardGeneratedCvc2")
 }
 
 type CardDebitService struct{ *service }
@@ -473,13 +522,13 @@ func (s *CardService) List(ctx context.Context, opts *ListOptions) iter.Seq2[Car
 	return listIter[Card](s.client, ctx, path, "Card", opts)
 }
 
-func (s *CardService) Update(ctx context.Context, cardID int, params CardUpdateParams) (*Card, error) {
+func (s *CardService) Update(ctx context.Context, cardID int, params CardUpdateParams) (*UpdateResult[Card], error) {
 	path := fmt.Sprintf("user/%d/card/%d", s.client.userID, cardID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
 		return nil, err
 	}
-	return unmarshalObject[Card](body, "Card")
+	return unmarshalUpdate[Card](body, "Card")
 }
 
 type CertificatePinnedService struct{ *service }
@@ -502,6 +551,14 @@ func (s *CertificatePinnedService) Get(ctx context.Context, certificatePinnedID
 	return unmarshalObject[CertificatePinned](body, "CertificatePinned")
 }
 
+func (s *CertificatePinnedService) CreateAndFetch(ctx context.Context, params CertificatePinnedCreateParams) (*CertificatePinned, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *CertificatePinnedService) List(ctx context.Context, opts *ListOptions) iter.Seq2[CertificatePinned, error] {
 	path := fmt.Sprintf("user/%d/certificate-pinned", s.client.userID)
 	return listIter[CertificatePinned](s.client, ctx, path, "CertificatePinned", opts)
@@ -543,18 +600,26 @@ func (s *CompanyService) Get(ctx context.Context, companyID int) (*Company, erro
 	return unmarshalObject[Company](body, "UserCompany")
 }
 
+func (s *CompanyService) CreateAndFetch(ctx context.Context, params CompanyCreateParams) (*Company, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *CompanyService) List(ctx context.Context, opts *ListOptions) iter.Seq2[Company, error] {
 	path := fmt.Sprintf("user/%d/company", s.client.userID)
 	return listIter[Company](s.client, ctx, path, "UserCompany", opts)
 }
 
-func (s *CompanyService) Update(ctx context.Context, companyID int, params CompanyUpdateParams) (int, error) {
+func (s *CompanyService) Update(ctx context.Context, companyID int, params CompanyUpdateParams) (*UpdateResult[Company], error) {
 	path := fmt.Sprintf("user/%d/company/%d", s.client.userID, companyID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[Company](body, "Company")
 }
 
 type UserCompanyService struct{ *service }
@@ -568,13 +633,13 @@ func (s *UserCompanyService) Get(ctx context.Context, userCompanyID int) (*UserC
 	return unmarshalObject[UserCompany](body, "UserCompany")
 }
 
-func (s *UserCompanyService) Update(ctx context.Context, userCompanyID int, params UserCompanyUpdateParams) (int, error) {
+func (s *UserCompanyService) Update(ctx context.Context, userCompanyID int, params UserCompanyUpdateParams) (*UpdateResult[UserCompany], error) {
 	path := fmt.Sprintf("user-company/%d", userCompanyID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[UserCompany](body, "UserCompany")
 }
 
 type ConfirmationOfFundsService struct{ *service }
@@ -615,6 +680,14 @@ func (s *CurrencyCloudBeneficiaryService) Get(ctx context.Context, currencyCloud
 	return unmarshalObject[CurrencyCloudBeneficiary](body, "CurrencyCloudBeneficiary")
 }
 
+func (s *CurrencyCloudBeneficiaryService) CreateAndFetch(ctx context.Context, params CurrencyCloudBeneficiaryCreateParams) (*CurrencyCloudBeneficiary, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *CurrencyCloudBeneficiaryService) List(ctx context.Context, opts *ListOptions) iter.Seq2[CurrencyCloudBeneficiary, error] {
 	path := fmt.Sprintf("user/%d/currency-cloud-beneficiary", s.client.userID)
 	return listIter[CurrencyCloudBeneficiary](s.client, ctx, path, "CurrencyCloudBeneficiary", opts)
@@ -651,13 +724,21 @@ func (s *CurrencyConversionQuoteService) Get(ctx context.Context, monetaryAccoun
 	return unmarshalObject[CurrencyConversionQuote](body, "CurrencyConversionQuote")
 }
 
-func (s *CurrencyConversionQuoteService) Update(ctx context.Context, monetaryAccountID int, currencyConversionQuoteID int, params CurrencyConversionQuoteUpdateParams) (int, error) {
+func (s *CurrencyConversionQuoteService) CreateAndFetch(ctx context.Context, monetaryAccountID int, params CurrencyConversionQuoteCreateParams) (*CurrencyConversionQuote, error) {
+	id, err := s.Create(ctx, monetaryAccountID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, id)
+}
+
+func (s *CurrencyConversionQuoteService) Update(ctx context.Context, monetaryAccountID int, currencyConversionQuoteID int, params CurrencyConversionQuoteUpdateParams) (*UpdateResult[CurrencyConversionQuote], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/currency-conversion-quote/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), currencyConversionQuoteID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[CurrencyConversionQuote](body, "CurrencyConversionQuote")
 }
 
 type CurrencyConversionService struct{ *service }
@@ -696,6 +777,14 @@ func (s *DeviceServerService) Get(ctx context.Context, deviceServerID int) (*Dev
 	return unmarshalObject[DeviceServer](body, "DeviceServer")
 }
 
+func (s *DeviceServerService) CreateAndFetch(ctx context.Context, params DeviceServerCreateParams) (*DeviceServer, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *DeviceServerService) List(ctx context.Context, opts *ListOptions) iter.Seq2[DeviceServer, error] {
 	path := "device-server"
 	return listIter[DeviceServer](s.client, ctx, path, "DeviceServer", opts)
@@ -737,18 +826,26 @@ func (s *DraftPaymentService) Get(ctx context.Context, monetaryAccountID int, dr
 	return unmarshalObject[DraftPayment](body, "DraftPayment")
 }
 
+func (s *DraftPaymentService) CreateAndFetch(ctx context.Context, monetaryAccountID int, params DraftPaymentCreateParams) (*DraftPayment, error) {
+	id, err := s.Create(ctx, monetaryAccountID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, id)
+}
+
 func (s *DraftPaymentService) List(ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[DraftPayment, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/draft-payment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
 	return listIter[DraftPayment](s.client, ctx, path, "DraftPayment", opts)
 }
 
-func (s *DraftPaymentService) Update(ctx context.Context, monetaryAccountID int, draftPaymentID int, params DraftPaymentUpdateParams) (int, error) {
+func (s *DraftPaymentService) Update(ctx context.Context, monetaryAccountID int, draftPaymentID int, params DraftPaymentUpdateParams) (*UpdateResult[DraftPayment], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/draft-payment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), draftPaymentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[DraftPayment](body, "DraftPayment")
 }
 
 type ScheduleService struct{ *service }
@@ -825,6 +922,14 @@ func (s *IdealMerchantTransactionService) Get(ctx context.Context, monetaryAccou
 	return unmarshalObject[IdealMerchantTransaction](body, "IdealMerchantTransaction")
 }
 
+func (s *IdealMerchantTransactionService) CreateAndFetch(ctx context.Context, monetaryAccountID int, params IdealMerchantTransactionCreateParams) (*IdealMerchantTransaction, error) {
+	id, err := s.Create(ctx, monetaryAccountID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, id)
+}
+
 func (s *IdealMerchantTransactionService) List(ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[IdealMerchantTransaction, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/ideal-merchant-transaction", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
 	return listIter[IdealMerchantTransaction](s.client, ctx, path, "IdealMerchantTransaction", opts)
@@ -850,18 +955,26 @@ func (s *SchedulePaymentService) Get(ctx context.Context, monetaryAccountID int,
 	return unmarshalObject[SchedulePayment](body, "ScheduledPayment")
 }
 
+func (s *SchedulePaymentService) CreateAndFetch(ctx context.Context, monetaryAccountID int, params SchedulePaymentCreateParams) (*SchedulePayment, error) {
+	id, err := s.Create(ctx, monetaryAccountID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, id)
+}
+
 func (s *SchedulePaymentService) List(ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[SchedulePayment, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-payment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
 	return listIter[SchedulePayment](s.client, ctx, path, "ScheduledPayment", opts)
 }
 
-func (s *SchedulePaymentService) Update(ctx context.Context, monetaryAccountID int, schedulePaymentID int, params SchedulePaymentUpdateParams) (*SchedulePayment, error) {
+func (s *SchedulePaymentService) Update(ctx context.Context, monetaryAccountID int, schedulePaymentID int, params SchedulePaymentUpdateParams) (*UpdateResult[SchedulePayment], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-payment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), schedulePaymentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
 		return nil, err
 	}
-	return unmarshalObject[SchedulePayment](body, "ScheduledPayment")
+	return unmarshalUpdate[SchedulePayment](body, "ScheduledPayment")
 }
 
 func (s *SchedulePaymentService) Delete(ctx context.Context, monetaryAccountID int, schedulePaymentID int) error {
@@ -889,13 +1002,21 @@ func (s *SchedulePaymentBatchService) Get(ctx context.Context, monetaryAccountID
 	return unmarshalObject[SchedulePaymentBatch](body, "ScheduledPaymentBatch")
 }
 
-func (s *SchedulePaymentBatchService) Update(ctx context.Context, monetaryAccountID int, schedulePaymentBatchID int, params SchedulePaymentBatchUpdateParams) (int, error) {
+func (s *SchedulePaymentBatchService) CreateAndFetch(ctx context.Context, monetaryAccountID int, params SchedulePaymentBatchCreateParams) (*SchedulePaymentBatch, error) {
+	id, err := s.Create(ctx, monetaryAccountID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, id)
+}
+
+func (s *SchedulePaymentBatchService) Update(ctx context.Context, monetaryAccountID int, schedulePaymentBatchID int, params SchedulePaymentBatchUpdateParams) (*UpdateResult[SchedulePaymentBatch], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-payment-batch/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), schedulePaymentBatchID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[SchedulePaymentBatch](body, "SchedulePaymentBatch")
 }
 
 func (s *SchedulePaymentBatchService) Delete(ctx context.Context, monetaryAccountID int, schedulePaymentBatchID int) error {
@@ -919,13 +1040,13 @@ func (s *ScheduleInstanceService) List(ctx context.Context, monetaryAccountID in
 	return listIter[ScheduleInstance](s.client, ctx, path, "ScheduledInstance", opts)
 }
 
-func (s *ScheduleInstanceService) Update(ctx context.Context, monetaryAccountID int, scheduleID int, scheduleInstanceID int, params ScheduleInstanceUpdateParams) (int, error) {
+func (s *ScheduleInstanceService) Update(ctx context.Context, monetaryAccountID int, scheduleID int, scheduleInstanceID int, params ScheduleInstanceUpdateParams) (*UpdateResult[ScheduleInstance], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule/%d/schedule-instance/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), scheduleID, scheduleInstanceID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[ScheduleInstance](body, "ScheduleInstance")
 }
 
 type MasterCardActionService struct{ *service }
@@ -964,18 +1085,26 @@ func (s *RequestInquiryBatchService) Get(ctx context.Context, monetaryAccountID
 	return unmarshalObject[RequestInquiryBatch](body, "RequestInquiryBatch")
 }
 
+func (s *RequestInquiryBatchService) CreateAndFetch(ctx context.Context, monetaryAccountID int, params RequestInquiryBatchCreateParams) (*RequestInquiryBatch, error) {
+	id, err := s.Create(ctx, monetaryAccountID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, id)
+}
+
 func (s *RequestInquiryBatchService) List(ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[RequestInquiryBatch, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry-batch", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
 	return listIter[RequestInquiryBatch](s.client, ctx, path, "RequestInquiryBatch", opts)
 }
 
-func (s *RequestInquiryBatchService) Update(ctx context.Context, monetaryAccountID int, requestInquiryBatchID int, params RequestInquiryBatchUpdateParams) (int, error) {
+func (s *RequestInquiryBatchService) Update(ctx context.Context, monetaryAccountID int, requestInquiryBatchID int, params RequestInquiryBatchUpdateParams) (*UpdateResult[RequestInquiryBatch], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry-batch/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestInquiryBatchID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[RequestInquiryBatch](body, "RequestInquiryBatch")
 }
 
 type RequestInquiryService struct{ *service }
@@ -998,18 +1127,26 @@ func (s *RequestInquiryService) Get(ctx context.Context, monetaryAccountID int,
 	return unmarshalObject[RequestInquiry](body, "RequestInquiry")
 }
 
+func (s *RequestInquiryService) CreateAndFetch(ctx context.Context, monetaryAccountID int, params RequestInquiryCreateParams) (*RequestInquiry, error) {
+	id, err := s.Create(ctx, monetaryAccountID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, id)
+}
+
 func (s *RequestInquiryService) List(ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[RequestInquiry, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
 	return listIter[RequestInquiry](s.client, ctx, path, "RequestInquiry", opts)
 }
 
-func (s *RequestInquiryService) Update(ctx context.Context, monetaryAccountID int, requestInquiryID int, params RequestInquiryUpdateParams) (*RequestInquiry, error) {
+func (s *RequestInquiryService) Update(ctx context.Context, monetaryAccountID int, requestInquiryID int, params RequestInquiryUpdateParams) (*UpdateResult[RequestInquiry], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestInquiryID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
 		return nil, err
 	}
-	return unmarshalObject[RequestInquiry](body, "RequestInquiry")
+	return unmarshalUpdate[RequestInquiry](body, "RequestInquiry")
 }
 
 type RequestResponseService struct{ *service }
@@ -1028,13 +1165,13 @@ func (s *RequestResponseService) List(ctx context.Context, monetaryAccountID int
 	return listIter[RequestResponse](s.client, ctx, path, "RequestResponse", opts)
 }
 
-func (s *RequestResponseService) Update(ctx context.Context, monetaryAccountID int, requestResponseID int, params RequestResponseUpdateParams) (*RequestResponse, error) {
+func (s *RequestResponseService) Update(ctx context.Context, monetaryAccountID int, requestResponseID int, params RequestResponseUpdateParams) (*UpdateResult[RequestResponse], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-response/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestResponseID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
 		return nil, err
 	}
-	return unmarshalObject[RequestResponse](body, "RequestResponse")
+	return unmarshalUpdate[RequestResponse](body, "RequestResponse")
 }
 
 type TransferwiseTransferService struct{ *service }
@@ -1057,6 +1194,14 @@ func (s *TransferwiseTransferService) Get(ctx context.Context, transferwiseQuote
 	return unmarshalObject[TransferwiseTransfer](body, "TransferwisePayment")
 }
 
+func (s *TransferwiseTransferService) CreateAndFetch(ctx context.Context, transferwiseQuoteID int, params TransferwiseTransferCreateParams) (*TransferwiseTransfer, error) {
+	id, err := s.Create(ctx, transferwiseQuoteID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), transferwiseQuoteID, id)
+}
+
 func (s *TransferwiseTransferService) List(ctx context.Context, transferwiseQuoteID int, opts *ListOptions) iter.Seq2[TransferwiseTransfer, error] {
 	path := fmt.Sprintf("user/%d/transferwise-quote/%d/transferwise-transfer", s.client.userID, transferwiseQuoteID)
 	return listIter[TransferwiseTransfer](s.client, ctx, path, "TransferwisePayment", opts)
@@ -1082,6 +1227,14 @@ func (s *TransferwiseQuoteService) Get(ctx context.Context, transferwiseQuoteID
 	return unmarshalObject[TransferwiseQuote](body, "TransferwiseQuote")
 }
 
+func (s *TransferwiseQuoteService) CreateAndFetch(ctx context.Context, params TransferwiseQuoteCreateParams) (*TransferwiseQuote, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 type ShareInviteMonetaryAccountInquiryService struct{ *service }
 
 func (s *ShareInviteMonetaryAccountInquiryService) Create(ctx context.Context, monetaryAccountID int, params ShareInviteMonetaryAccountInquiryCreateParams) (int, error) {
@@ -1102,18 +1255,26 @@ func (s *ShareInviteMonetaryAccountInquiryService) Get(ctx context.Context, mone
 	return unmarshalObject[ShareInviteMonetaryAccountInquiry](body, "ShareInviteMonetaryAccountInquiry")
 }
 
+func (s *ShareInviteMonetaryAccountInquiryService) CreateAndFetch(ctx context.Context, monetaryAccountID int, params ShareInviteMonetaryAccountInquiryCreateParams) (*ShareInviteMonetaryAccountInquiry, error) {
+	id, err := s.Create(ctx, monetaryAccountID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, id)
+}
+
 func (s *ShareInviteMonetaryAccountInquiryService) List(ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[ShareInviteMonetaryAccountInquiry, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/share-invite-monetary-account-inquiry", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
 	return listIter[ShareInviteMonetaryAccountInquiry](s.client, ctx, path, "ShareInviteMonetaryAccountInquiry", opts)
 }
 
-func (s *ShareInviteMonetaryAccountInquiryService) Update(ctx context.Context, monetaryAccountID int, shareInviteMonetaryAccountInquiryID int, params ShareInviteMonetaryAccountInquiryUpdateParams) (int, error) {
+func (s *ShareInviteMonetaryAccountInquiryService) Update(ctx context.Context, monetaryAccountID int, shareInviteMonetaryAccountInquiryID int, params ShareInviteMonetaryAccountInquiryUpdateParams) (*UpdateResult[ShareInviteMonetaryAccountInquiry], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/share-invite-monetary-account-inquiry/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), shareInviteMonetaryAccountInquiryID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[ShareInviteMonetaryAccountInquiry](body, "ShareInviteMonetaryAccountInquiry")
 }
 
 type ShareInviteMonetaryAccountResponseService struct{ *service }
@@ -1132,13 +1293,13 @@ func (s *ShareInviteMonetaryAccountResponseService) List(ctx context.Context, op
 	return listIter[ShareInviteMonetaryAccountResponse](s.client, ctx, path, "ShareInviteMonetaryAccountResponse", opts)
 }
 
-func (s *ShareInviteMonetaryAccountResponseService) Update(ctx context.Context, shareInviteMonetaryAccountResponseID int, params ShareInviteMonetaryAccountResponseUpdateParams) (int, error) {
+func (s *ShareInviteMonetaryAccountResponseService) Update(ctx context.Context, shareInviteMonetaryAccountResponseID int, params ShareInviteMonetaryAccountResponseUpdateParams) (*UpdateResult[ShareInviteMonetaryAccountResponse], error) {
 	path := fmt.Sprintf("user/%d/share-invite-monetary-account-response/%d", s.client.userID, shareInviteMonetaryAccountResponseID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[ShareInviteMonetaryAccountResponse](body, "ShareInviteMonetaryAccountResponse")
 }
 
 type SofortMerchantTransactionService struct{ *service }
@@ -1184,6 +1345,14 @@ func (s *ExportAnnualOverviewService) Get(ctx context.Context, exportAnnualOverv
 	return unmarshalObject[ExportAnnualOverview](body, "ExportAnnualOverview")
 }
 
+func (s *ExportAnnualOverviewService) CreateAndFetch(ctx context.Context, params ExportAnnualOverviewCreateParams) (*ExportAnnualOverview, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *ExportAnnualOverviewService) List(ctx context.Context, opts *ListOptions) iter.Seq2[ExportAnnualOverview, error] {
 	path := fmt.Sprintf("user/%d/export-annual-overview", s.client.userID)
 	return listIter[ExportAnnualOverview](s.client, ctx, path, "ExportAnnualOverview", opts)
@@ -1221,6 +1390,14 @@ func (s *ExportRibService) Get(ctx context.Context, monetaryAccountID int, expor
 	return unmarshalObject[ExportRib](body, "ExportRib")
 }
 
+func (s *ExportRibService) CreateAndFetch(ctx context.Context, monetaryAccountID int) (*ExportRib, error) {
+	id, err := s.Create(ctx, monetaryAccountID)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, id)
+}
+
 func (s *ExportRibService) List(ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[ExportRib, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/export-rib", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
 	return listIter[ExportRib](s.client, ctx, path, "ExportRib", opts)
@@ -1251,6 +1428,14 @@ func (s *ExportStatementCardCsvService) Get(ctx context.Context, cardID int, exp
 	return unmarshalObject[ExportStatementCardCsv](body, "ExportStatementCardCsv")
 }
 
+func (s *ExportStatementCardCsvService) CreateAndFetch(ctx context.Context, cardID int, params ExportStatementCardCsvCreateParams) (*ExportStatementCardCsv, error) {
+	id, err := s.Create(ctx, cardID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), cardID, id)
+}
+
 func (s *ExportStatementCardCsvService) List(ctx context.Context, cardID int, opts *ListOptions) iter.Seq2[ExportStatementCardCsv, error] {
 	path := fmt.Sprintf("user/%d/card/%d/export-statement-card-csv", s.client.userID, cardID)
 	return listIter[ExportStatementCardCsv](s.client, ctx, path, "ExportStatementCardCsv", opts)
@@ -1281,6 +1466,14 @@ func (s *ExportStatementCardPdfService) Get(ctx context.Context, cardID int, exp
 	return unmarshalObject[ExportStatementCardPdf](body, "ExportStatementCardPdf")
 }
 
+func (s *ExportStatementCardPdfService) CreateAndFetch(ctx context.Context, cardID int, params ExportStatementCardPdfCreateParams) (*ExportStatementCardPdf, error) {
+	id, err := s.Create(ctx, cardID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), cardID, id)
+}
+
 func (s *ExportStatementCardPdfService) List(ctx context.Context, cardID int, opts *ListOptions) iter.Seq2[ExportStatementCardPdf, error] {
 	path := fmt.Sprintf("user/%d/card/%d/export-statement-card-pdf", s.client.userID, cardID)
 	return listIter[ExportStatementCardPdf](s.client, ctx, path, "ExportStatementCardPdf", opts)
@@ -1348,6 +1541,14 @@ func (s *ExportStatementPaymentService) Get(ctx context.Context, monetaryAccount
 	return unmarshalObject[ExportStatementPayment](body, "ExportStatementPayment")
 }
 
+func (s *ExportStatementPaymentService) CreateAndFetch(ctx context.Context, monetaryAccountID int, eventID int) (*ExportStatementPayment, error) {
+	id, err := s.Create(ctx, monetaryAccountID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, eventID, id)
+}
+
 type ExportStatementService struct{ *service }
 
 func (s *ExportStatementService) Create(ctx context.Context, monetaryAccountID int, params ExportStatementCreateParams) (int, error) {
@@ -1368,6 +1569,14 @@ func (s *ExportStatementService) Get(ctx context.Context, monetaryAccountID int,
 	return unmarshalObject[ExportStatement](body, "CustomerStatement")
 }
 
+func (s *ExportStatementService) CreateAndFetch(ctx context.Context, monetaryAccountID int, params ExportStatementCreateParams) (*ExportStatement, error) {
+	id, err := s.Create(ctx, monetaryAccountID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, id)
+}
+
 func (s *ExportStatementService) List(ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[ExportStatement, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/customer-statement", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
 	return listIter[ExportStatement](s.client, ctx, path, "CustomerStatement", opts)
@@ -1426,18 +1635,26 @@ func (s *MonetaryAccountBankService) Get(ctx context.Context, monetaryAccountBan
 	return unmarshalObject[MonetaryAccountBank](body, "MonetaryAccountBank")
 }
 
+func (s *MonetaryAccountBankService) CreateAndFetch(ctx context.Context, params MonetaryAccountBankCreateParams) (*MonetaryAccountBank, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *MonetaryAccountBankService) List(ctx context.Context, opts *ListOptions) iter.Seq2[MonetaryAccountBank, error] {
 	path := fmt.Sprintf("user/%d/monetary-account-bank", s.client.userID)
 	return listIter[MonetaryAccountBank](s.client, ctx, path, "MonetaryAccountBank", opts)
 }
 
-func (s *MonetaryAccountBankService) Update(ctx context.Context, monetaryAccountBankID int, params MonetaryAccountBankUpdateParams) (int, error) {
+func (s *MonetaryAccountBankService) Update(ctx context.Context, monetaryAccountBankID int, params MonetaryAccountBankUpdateParams) (*UpdateResult[MonetaryAccountBank], error) {
 	path := fmt.Sprintf("user/%d/monetary-account-bank/%d", s.client.userID, monetaryAccountBankID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[MonetaryAccountBank](body, "MonetaryAccountBank")
 }
 
 type MonetaryAccountCardService struct{ *service }
@@ -1456,13 +1673,13 @@ func (s *MonetaryAccountCardService) List(ctx context.Context, opts *ListOptions
 	return listIter[MonetaryAccountCard](s.client, ctx, path, "MonetaryAccountCard", opts)
 }
 
-func (s *MonetaryAccountCardService) Update(ctx context.Context, monetaryAccountCardID int) (int, error) {
+func (s *MonetaryAccountCardService) Update(ctx context.Context, monetaryAccountCardID int) (*UpdateResult[MonetaryAccountCard], error) {
 	path := fmt.Sprintf("user/%d/monetary-account-card/%d", s.client.userID, monetaryAccountCardID)
 	body, _, err := s.client.put(ctx, path, nil)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[MonetaryAccountCard](body, "MonetaryAccountCard")
 }
 
 type MonetaryAccountExternalSavingsService struct{ *service }
@@ -1485,18 +1702,26 @@ func (s *MonetaryAccountExternalSavingsService) Get(ctx context.Context, monetar
 	return unmarshalObject[MonetaryAccountExternalSavings](body, "MonetaryAccountExternalSavings")
 }
 
+func (s *MonetaryAccountExternalSavingsService) CreateAndFetch(ctx context.Context, params MonetaryAccountExternalSavingsCreateParams) (*MonetaryAccountExternalSavings, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *MonetaryAccountExternalSavingsService) List(ctx context.Context, opts *ListOptions) iter.Seq2[MonetaryAccountExternalSavings, error] {
 	path := fmt.Sprintf("user/%d/monetary-account-external-savings", s.client.userID)
 	return listIter[MonetaryAccountExternalSavings](s.client, ctx, path, "MonetaryAccountExternalSavings", opts)
 }
 
-func (s *MonetaryAccountExternalSavingsService) Update(ctx context.Context, monetaryAccountExternalSavingsID int, params MonetaryAccountExternalSavingsUpdateParams) (int, error) {
+func (s *MonetaryAccountExternalSavingsService) Update(ctx context.Context, monetaryAccountExternalSavingsID int, params MonetaryAccountExternalSavingsUpdateParams) (*UpdateResult[MonetaryAccountExternalSavings], error) {
 	path := fmt.Sprintf("user/%d/monetary-account-external-savings/%d", s.client.userID, monetaryAccountExternalSavingsID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[MonetaryAccountExternalSavings](body, "MonetaryAccountExternalSavings")
 }
 
 type MonetaryAccountExternalService struct{ *service }
@@ -1519,18 +1744,26 @@ func (s *MonetaryAccountExternalService) Get(ctx context.Context, monetaryAccoun
 	return unmarshalObject[MonetaryAccountExternal](body, "MonetaryAccountExternal")
 }
 
+func (s *MonetaryAccountExternalService) CreateAndFetch(ctx context.Context, params MonetaryAccountExternalCreateParams) (*MonetaryAccountExternal, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *MonetaryAccountExternalService) List(ctx context.Context, opts *ListOptions) iter.Seq2[MonetaryAccountExternal, error] {
 	path := fmt.Sprintf("user/%d/monetary-account-external", s.client.userID)
 	return listIter[MonetaryAccountExternal](s.client, ctx, path, "MonetaryAccountExternal", opts)
 }
 
-func (s *MonetaryAccountExternalService) Update(ctx context.Context, monetaryAccountExternalID int, params MonetaryAccountExternalUpdateParams) (int, error) {
+func (s *MonetaryAccountExternalService) Update(ctx context.Context, monetaryAccountExternalID int, params MonetaryAccountExternalUpdateParams) (*UpdateResult[MonetaryAccountExternal], error) {
 	path := fmt.Sprintf("user/%d/monetary-account-external/%d", s.client.userID, monetaryAccountExternalID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[MonetaryAccountExternal](body, "MonetaryAccountExternal")
 }
 
 type MonetaryAccountJointService struct{ *service }
@@ -1553,18 +1786,26 @@ func (s *MonetaryAccountJointService) Get(ctx context.Context, monetaryAccountJo
 	return unmarshalObject[MonetaryAccountJoint](body, "MonetaryAccountJoint")
 }
 
+func (s *MonetaryAccountJointService) CreateAndFetch(ctx context.Context, params MonetaryAccountJointCreateParams) (*MonetaryAccountJoint, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *MonetaryAccountJointService) List(ctx context.Context, opts *ListOptions) iter.Seq2[MonetaryAccountJoint, error] {
 	path := fmt.Sprintf("user/%d/monetary-account-joint", s.client.userID)
 	return listIter[MonetaryAccountJoint](s.client, ctx, path, "MonetaryAccountJoint", opts)
 }
 
-func (s *MonetaryAccountJointService) Update(ctx context.Context, monetaryAccountJointID int, params MonetaryAccountJointUpdateParams) (int, error) {
+func (s *MonetaryAccountJointService) Update(ctx context.Context, monetaryAccountJointID int, params MonetaryAccountJointUpdateParams) (*UpdateResult[MonetaryAccountJoint], error) {
 	path := fmt.Sprintf("user/%d/monetary-account-joint/%d", s.client.userID, monetaryAccountJointID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[MonetaryAccountJoint](body, "MonetaryAccountJoint")
 }
 
 type MonetaryAccountSavingsService struct{ *service }
@@ -1587,18 +1828,26 @@ func (s *MonetaryAccountSavingsService) Get(ctx context.Context, monetaryAccount
 	return unmarshalObject[MonetaryAccountSavings](body, "MonetaryAccountSavings")
 }
 
+func (s *MonetaryAccountSavingsService) CreateAndFetch(ctx context.Context, params MonetaryAccountSavingsCreateParams) (*MonetaryAccountSavings, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *MonetaryAccountSavingsService) List(ctx context.Context, opts *ListOptions) iter.Seq2[MonetaryAccountSavings, error] {
 	path := fmt.Sprintf("user/%d/monetary-account-savings", s.client.userID)
 	return listIter[MonetaryAccountSavings](s.client, ctx, path, "MonetaryAccountSavings", opts)
 }
 
-func (s *MonetaryAccountSavingsService) Update(ctx context.Context, monetaryAccountSavingsID int, params MonetaryAccountSavingsUpdateParams) (int, error) {
+func (s *MonetaryAccountSavingsService) Update(ctx context.Context, monetaryAccountSavingsID int, params MonetaryAccountSavingsUpdateParams) (*UpdateResult[MonetaryAccountSavings], error) {
 	path := fmt.Sprintf("user/%d/monetary-account-savings/%d", s.client.userID, monetaryAccountSavingsID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[MonetaryAccountSavings](body, "MonetaryAccountSavings")
 }
 
 type MonetaryAccountService struct{ *service }
@@ -1637,18 +1886,26 @@ func (s *NoteAttachmentAdyenCardTransactionService) Get(ctx context.Context, mon
 	return unmarshalObject[NoteAttachmentAdyenCardTransaction](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentAdyenCardTransactionService) CreateAndFetch(ctx context.Context, monetaryAccountID int, adyenCardTransactionID int, params NoteAttachmentAdyenCardTransactionCreateParams) (*NoteAttachmentAdyenCardTransaction, error) {
+	id, err := s.Create(ctx, monetaryAccountID, adyenCardTransactionID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, adyenCardTransactionID, id)
+}
+
 func (s *NoteAttachmentAdyenCardTransactionService) List(ctx context.Context, monetaryAccountID int, adyenCardTransactionID int, opts *ListOptions) iter.Seq2[NoteAttachmentAdyenCardTransaction, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/adyen-card-transaction/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), adyenCardTransactionID)
 	return listIter[NoteAttachmentAdyenCardTransaction](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentAdyenCardTransactionService) Update(ctx context.Context, monetaryAccountID int, adyenCardTransactionID int, noteAttachmentID int, params NoteAttachmentAdyenCardTransactionUpdateParams) (int, error) {
+func (s *NoteAttachmentAdyenCardTransactionService) Update(ctx context.Context, monetaryAccountID int, adyenCardTransactionID int, noteAttachmentID int, params NoteAttachmentAdyenCardTransactionUpdateParams) (*UpdateResult[NoteAttachmentAdyenCardTransaction], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/adyen-card-transaction/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), adyenCardTransactionID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentAdyenCardTransaction](body, "NoteAttachmentAdyenCardTransaction")
 }
 
 func (s *NoteAttachmentAdyenCardTransactionService) Delete(ctx context.Context, monetaryAccountID int, adyenCardTransactionID int, noteAttachmentID int) error {
@@ -1676,18 +1933,26 @@ func (s *NoteTextAdyenCardTransactionService) Get(ctx context.Context, monetaryA
 	return unmarshalObject[NoteTextAdyenCardTransaction](body, "NoteText")
 }
 
+func (s *NoteTextAdyenCardTransactionService) CreateAndFetch(ctx context.Context, monetaryAccountID int, adyenCardTransactionID int, params NoteTextAdyenCardTransactionCreateParams) (*NoteTextAdyenCardTransaction, error) {
+	id, err := s.Create(ctx, monetaryAccountID, adyenCardTransactionID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, adyenCardTransactionID, id)
+}
+
 func (s *NoteTextAdyenCardTransactionService) List(ctx context.Context, monetaryAccountID int, adyenCardTransactionID int, opts *ListOptions) iter.Seq2[NoteTextAdyenCardTransaction, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/adyen-card-transaction/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), adyenCardTransactionID)
 	return listIter[NoteTextAdyenCardTransaction](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextAdyenCardTransactionService) Update(ctx context.Context, monetaryAccountID int, adyenCardTransactionID int, noteTextID int, params NoteTextAdyenCardTransactionUpdateParams) (int, error) {
+func (s *NoteTextAdyenCardTransactionService) Update(ctx context.Context, monetaryAccountID int, adyenCardTransactionID int, noteTextID int, params NoteTextAdyenCardTransactionUpdateParams) (*UpdateResult[NoteTextAdyenCardTransaction], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/adyen-card-transaction/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), adyenCardTransactionID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextAdyenCardTransaction](body, "NoteTextAdyenCardTransaction")
 }
 
 func (s *NoteTextAdyenCardTransactionService) Delete(ctx context.Context, monetaryAccountID int, adyenCardTransactionID int, noteTextID int) error {
@@ -1715,18 +1980,26 @@ func (s *NoteAttachmentBankSwitchServiceNetherlandsIncomingPaymentService) Get(c
 	return unmarshalObject[NoteAttachmentBankSwitchServiceNetherlandsIncomingPayment](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentBankSwitchServiceNetherlandsIncomingPaymentService) CreateAndFetch(ctx context.Context, monetaryAccountID int, switchServicePaymentID int, params NoteAttachmentBankSwitchServiceNetherlandsIncomingPaymentCreateParams) (*NoteAttachmentBankSwitchServiceNetherlandsIncomingPayment, error) {
+	id, err := s.Create(ctx, monetaryAccountID, switchServicePaymentID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, switchServicePaymentID, id)
+}
+
 func (s *NoteAttachmentBankSwitchServiceNetherlandsIncomingPaymentService) List(ctx context.Context, monetaryAccountID int, switchServicePaymentID int, opts *ListOptions) iter.Seq2[NoteAttachmentBankSwitchServiceNetherlandsIncomingPayment, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/switch-service-payment/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), switchServicePaymentID)
 	return listIter[NoteAttachmentBankSwitchServiceNetherlandsIncomingPayment](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentBankSwitchServiceNetherlandsIncomingPaymentService) Update(ctx context.Context, monetaryAccountID int, switchServicePaymentID int, noteAttachmentID int, params NoteAttachmentBankSwitchServiceNetherlandsIncomingPaymentUpdateParams) (int, error) {
+func (s *NoteAttachmentBankSwitchServiceNetherlandsIncomingPaymentService) Update(ctx context.Context, monetaryAccountID int, switchServicePaymentID int, noteAttachmentID int, params NoteAttachmentBankSwitchServiceNetherlandsIncomingPaymentUpdateParams) (*UpdateResult[NoteAttachmentBankSwitchServiceNetherlandsIncomingPayment], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/switch-service-payment/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), switchServicePaymentID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentBankSwitchServiceNetherlandsIncomingPayment](body, "NoteAttachmentBankSwitchServiceNetherlandsIncomingPayment")
 }
 
 func (s *NoteAttachmentBankSwitchServiceNetherlandsIncomingPaymentService) Delete(ctx context.Context, monetaryAccountID int, switchServicePaymentID int, noteAttachmentID int) error {
@@ -1754,18 +2027,26 @@ func (s *NoteTextBankSwitchServiceNetherlandsIncomingPaymentService) Get(ctx con
 	return unmarshalObject[NoteTextBankSwitchServiceNetherlandsIncomingPayment](body, "NoteText")
 }
 
+func (s *NoteTextBankSwitchServiceNetherlandsIncomingPaymentService) CreateAndFetch(ctx context.Context, monetaryAccountID int, switchServicePaymentID int, params NoteTextBankSwitchServiceNetherlandsIncomingPaymentCreateParams) (*NoteTextBankSwitchServiceNetherlandsIncomingPayment, error) {
+	id, err := s.Create(ctx, monetaryAccountID, switchServicePaymentID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, switchServicePaymentID, id)
+}
+
 func (s *NoteTextBankSwitchServiceNetherlandsIncomingPaymentService) List(ctx context.Context, monetaryAccountID int, switchServicePaymentID int, opts *ListOptions) iter.Seq2[NoteTextBankSwitchServiceNetherlandsIncomingPayment, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/switch-service-payment/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), switchServicePaymentID)
 	return listIter[NoteTextBankSwitchServiceNetherlandsIncomingPayment](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextBankSwitchServiceNetherlandsIncomingPaymentService) Update(ctx context.Context, monetaryAccountID int, switchServicePaymentID int, noteTextID int, params NoteTextBankSwitchServiceNetherlandsIncomingPaymentUpdateParams) (int, error) {
+func (s *NoteTextBankSwitchServiceNetherlandsIncomingPaymentService) Update(ctx context.Context, monetaryAccountID int, switchServicePaymentID int, noteTextID int, params NoteTextBankSwitchServiceNetherlandsIncomingPaymentUpdateParams) (*UpdateResult[NoteTextBankSwitchServiceNetherlandsIncomingPayment], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/switch-service-payment/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), switchServicePaymentID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextBankSwitchServiceNetherlandsIncomingPayment](body, "NoteTextBankSwitchServiceNetherlandsIncomingPayment")
 }
 
 func (s *NoteTextBankSwitchServiceNetherlandsIncomingPaymentService) Delete(ctx context.Context, monetaryAccountID int, switchServicePaymentID int, noteTextID int) error {
@@ -1793,18 +2074,26 @@ func (s *NoteAttachmentBunqMeFundraiserResultService) Get(ctx context.Context, m
 	return unmarshalObject[NoteAttachmentBunqMeFundraiserResult](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentBunqMeFundraiserResultService) CreateAndFetch(ctx context.Context, monetaryAccountID int, bunqmeFundraiserResultID int, params NoteAttachmentBunqMeFundraiserResultCreateParams) (*NoteAttachmentBunqMeFundraiserResult, error) {
+	id, err := s.Create(ctx, monetaryAccountID, bunqmeFundraiserResultID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, bunqmeFundraiserResultID, id)
+}
+
 func (s *NoteAttachmentBunqMeFundraiserResultService) List(ctx context.Context, monetaryAccountID int, bunqmeFundraiserResultID int, opts *ListOptions) iter.Seq2[NoteAttachmentBunqMeFundraiserResult, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/bunqme-fundraiser-result/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), bunqmeFundraiserResultID)
 	return listIter[NoteAttachmentBunqMeFundraiserResult](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentBunqMeFundraiserResultService) Update(ctx context.Context, monetaryAccountID int, bunqmeFundraiserResultID int, noteAttachmentID int, params NoteAttachmentBunqMeFundraiserResultUpdateParams) (int, error) {
+func (s *NoteAttachmentBunqMeFundraiserResultService) Update(ctx context.Context, monetaryAccountID int, bunqmeFundraiserResultID int, noteAttachmentID int, params NoteAttachmentBunqMeFundraiserResultUpdateParams) (*UpdateResult[NoteAttachmentBunqMeFundraiserResult], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/bunqme-fundraiser-result/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), bunqmeFundraiserResultID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentBunqMeFundraiserResult](body, "NoteAttachmentBunqMeFundraiserResult")
 }
 
 func (s *NoteAttachmentBunqMeFundraiserResultService) Delete(ctx context.Context, monetaryAccountID int, bunqmeFundraiserResultID int, noteAttachmentID int) error {
@@ -1832,18 +2121,26 @@ func (s *NoteTextBunqMeFundraiserResultService) Get(ctx context.Context, monetar
 	return unmarshalObject[NoteTextBunqMeFundraiserResult](body, "NoteText")
 }
 
+func (s *NoteTextBunqMeFundraiserResultService) CreateAndFetch(ctx context.Context, monetaryAccountID int, bunqmeFundraiserResultID int, params NoteTextBunqMeFundraiserResultCreateParams) (*NoteTextBunqMeFundraiserResult, error) {
+	id, err := s.Create(ctx, monetaryAccountID, bunqmeFundraiserResultID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, bunqmeFundraiserResultID, id)
+}
+
 func (s *NoteTextBunqMeFundraiserResultService) List(ctx context.Context, monetaryAccountID int, bunqmeFundraiserResultID int, opts *ListOptions) iter.Seq2[NoteTextBunqMeFundraiserResult, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/bunqme-fundraiser-result/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), bunqmeFundraiserResultID)
 	return listIter[NoteTextBunqMeFundraiserResult](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextBunqMeFundraiserResultService) Update(ctx context.Context, monetaryAccountID int, bunqmeFundraiserResultID int, noteTextID int, params NoteTextBunqMeFundraiserResultUpdateParams) (int, error) {
+func (s *NoteTextBunqMeFundraiserResultService) Update(ctx context.Context, monetaryAccountID int, bunqmeFundraiserResultID int, noteTextID int, params NoteTextBunqMeFundraiserResultUpdateParams) (*UpdateResult[NoteTextBunqMeFundraiserResult], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/bunqme-fundraiser-result/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), bunqmeFundraiserResultID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextBunqMeFundraiserResult](body, "NoteTextBunqMeFundraiserResult")
 }
 
 func (s *NoteTextBunqMeFundraiserResultService) Delete(ctx context.Context, monetaryAccountID int, bunqmeFundraiserResultID int, noteTextID int) error {
@@ -1871,18 +2168,26 @@ func (s *NoteAttachmentDraftPaymentService) Get(ctx context.Context, monetaryAcc
 	return unmarshalObject[NoteAttachmentDraftPayment](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentDraftPaymentService) CreateAndFetch(ctx context.Context, monetaryAccountID int, draftPaymentID int, params NoteAttachmentDraftPaymentCreateParams) (*NoteAttachmentDraftPayment, error) {
+	id, err := s.Create(ctx, monetaryAccountID, draftPaymentID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, draftPaymentID, id)
+}
+
 func (s *NoteAttachmentDraftPaymentService) List(ctx context.Context, monetaryAccountID int, draftPaymentID int, opts *ListOptions) iter.Seq2[NoteAttachmentDraftPayment, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/draft-payment/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), draftPaymentID)
 	return listIter[NoteAttachmentDraftPayment](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentDraftPaymentService) Update(ctx context.Context, monetaryAccountID int, draftPaymentID int, noteAttachmentID int, params NoteAttachmentDraftPaymentUpdateParams) (int, error) {
+func (s *NoteAttachmentDraftPaymentService) Update(ctx context.Context, monetaryAccountID int, draftPaymentID int, noteAttachmentID int, params NoteAttachmentDraftPaymentUpdateParams) (*UpdateResult[NoteAttachmentDraftPayment], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/draft-payment/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), draftPaymentID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentDraftPayment](body, "NoteAttachmentDraftPayment")
 }
 
 func (s *NoteAttachmentDraftPaymentService) Delete(ctx context.Context, monetaryAccountID int, draftPaymentID int, noteAttachmentID int) error {
@@ -1910,18 +2215,26 @@ func (s *NoteTextDraftPaymentService) Get(ctx context.Context, monetaryAccountID
 	return unmarshalObject[NoteTextDraftPayment](body, "NoteText")
 }
 
+func (s *NoteTextDraftPaymentService) CreateAndFetch(ctx context.Context, monetaryAccountID int, draftPaymentID int, params NoteTextDraftPaymentCreateParams) (*NoteTextDraftPayment, error) {
+	id, err := s.Create(ctx, monetaryAccountID, draftPaymentID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, draftPaymentID, id)
+}
+
 func (s *NoteTextDraftPaymentService) List(ctx context.Context, monetaryAccountID int, draftPaymentID int, opts *ListOptions) iter.Seq2[NoteTextDraftPayment, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/draft-payment/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), draftPaymentID)
 	return listIter[NoteTextDraftPayment](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextDraftPaymentService) Update(ctx context.Context, monetaryAccountID int, draftPaymentID int, noteTextID int, params NoteTextDraftPaymentUpdateParams) (int, error) {
+func (s *NoteTextDraftPaymentService) Update(ctx context.Context, monetaryAccountID int, draftPaymentID int, noteTextID int, params NoteTextDraftPaymentUpdateParams) (*UpdateResult[NoteTextDraftPayment], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/draft-payment/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), draftPaymentID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextDraftPayment](body, "NoteTextDraftPayment")
 }
 
 func (s *NoteTextDraftPaymentService) Delete(ctx context.Context, monetaryAccountID int, draftPaymentID int, noteTextID int) error {
@@ -1949,18 +2262,26 @@ func (s *NoteAttachmentIdealMerchantTransactionService) Get(ctx context.Context,
 	return unmarshalObject[NoteAttachmentIdealMerchantTransaction](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentIdealMerchantTransactionService) CreateAndFetch(ctx context.Context, monetaryAccountID int, idealMerchantTransactionID int, params NoteAttachmentIdealMerchantTransactionCreateParams) (*NoteAttachmentIdealMerchantTransaction, error) {
+	id, err := s.Create(ctx, monetaryAccountID, idealMerchantTransactionID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, idealMerchantTransactionID, id)
+}
+
 func (s *NoteAttachmentIdealMerchantTransactionService) List(ctx context.Context, monetaryAccountID int, idealMerchantTransactionID int, opts *ListOptions) iter.Seq2[NoteAttachmentIdealMerchantTransaction, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/ideal-merchant-transaction/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), idealMerchantTransactionID)
 	return listIter[NoteAttachmentIdealMerchantTransaction](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentIdealMerchantTransactionService) Update(ctx context.Context, monetaryAccountID int, idealMerchantTransactionID int, noteAttachmentID int, params NoteAttachmentIdealMerchantTransactionUpdateParams) (int, error) {
+func (s *NoteAttachmentIdealMerchantTransactionService) Update(ctx context.Context, monetaryAccountID int, idealMerchantTransactionID int, noteAttachmentID int, params NoteAttachmentIdealMerchantTransactionUpdateParams) (*UpdateResult[NoteAttachmentIdealMerchantTransaction], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/ideal-merchant-transaction/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), idealMerchantTransactionID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentIdealMerchantTransaction](body, "NoteAttachmentIdealMerchantTransaction")
 }
 
 func (s *NoteAttachmentIdealMerchantTransactionService) Delete(ctx context.Context, monetaryAccountID int, idealMerchantTransactionID int, noteAttachmentID int) error {
@@ -1988,18 +2309,26 @@ func (s *NoteTextIdealMerchantTransactionService) Get(ctx context.Context, monet
 	return unmarshalObject[NoteTextIdealMerchantTransaction](body, "NoteText")
 }
 
+func (s *NoteTextIdealMerchantTransactionService) CreateAndFetch(ctx context.Context, monetaryAccountID int, idealMerchantTransactionID int, params NoteTextIdealMerchantTransactionCreateParams) (*NoteTextIdealMerchantTransaction, error) {
+	id, err := s.Create(ctx, monetaryAccountID, idealMerchantTransactionID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, idealMerchantTransactionID, id)
+}
+
 func (s *NoteTextIdealMerchantTransactionService) List(ctx context.Context, monetaryAccountID int, idealMerchantTransactionID int, opts *ListOptions) iter.Seq2[NoteTextIdealMerchantTransaction, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/ideal-merchant-transaction/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), idealMerchantTransactionID)
 	return listIter[NoteTextIdealMerchantTransaction](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextIdealMerchantTransactionService) Update(ctx context.Context, monetaryAccountID int, idealMerchantTransactionID int, noteTextID int, params NoteTextIdealMerchantTransactionUpdateParams) (int, error) {
+func (s *NoteTextIdealMerchantTransactionService) Update(ctx context.Context, monetaryAccountID int, idealMerchantTransactionID int, noteTextID int, params NoteTextIdealMerchantTransactionUpdateParams) (*UpdateResult[NoteTextIdealMerchantTransaction], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/ideal-merchant-transaction/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), idealMerchantTransactionID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextIdealMerchantTransaction](body, "NoteTextIdealMerchantTransaction")
 }
 
 func (s *NoteTextIdealMerchantTransactionService) Delete(ctx context.Context, monetaryAccountID int, idealMerchantTransactionID int, noteTextID int) error {
@@ -2027,18 +2356,26 @@ func (s *NoteAttachmentMasterCardActionService) Get(ctx context.Context, monetar
 	return unmarshalObject[NoteAttachmentMasterCardAction](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentMasterCardActionService) CreateAndFetch(ctx context.Context, monetaryAccountID int, mastercardActionID int, params NoteAttachmentMasterCardActionCreateParams) (*NoteAttachmentMasterCardAction, error) {
+	id, err := s.Create(ctx, monetaryAccountID, mastercardActionID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, mastercardActionID, id)
+}
+
 func (s *NoteAttachmentMasterCardActionService) List(ctx context.Context, monetaryAccountID int, mastercardActionID int, opts *ListOptions) iter.Seq2[NoteAttachmentMasterCardAction, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/mastercard-action/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), mastercardActionID)
 	return listIter[NoteAttachmentMasterCardAction](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentMasterCardActionService) Update(ctx context.Context, monetaryAccountID int, mastercardActionID int, noteAttachmentID int, params NoteAttachmentMasterCardActionUpdateParams) (int, error) {
+func (s *NoteAttachmentMasterCardActionService) Update(ctx context.Context, monetaryAccountID int, mastercardActionID int, noteAttachmentID int, params NoteAttachmentMasterCardActionUpdateParams) (*UpdateResult[NoteAttachmentMasterCardAction], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/mastercard-action/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), mastercardActionID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentMasterCardAction](body, "NoteAttachmentMasterCardAction")
 }
 
 func (s *NoteAttachmentMasterCardActionService) Delete(ctx context.Context, monetaryAccountID int, mastercardActionID int, noteAttachmentID int) error {
@@ -2066,18 +2403,26 @@ func (s *NoteTextMasterCardActionService) Get(ctx context.Context, monetaryAccou
 	return unmarshalObject[NoteTextMasterCardAction](body, "NoteText")
 }
 
+func (s *NoteTextMasterCardActionService) CreateAndFetch(ctx context.Context, monetaryAccountID int, mastercardActionID int, params NoteTextMasterCardActionCreateParams) (*NoteTextMasterCardAction, error) {
+	id, err := s.Create(ctx, monetaryAccountID, mastercardActionID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, mastercardActionID, id)
+}
+
 func (s *NoteTextMasterCardActionService) List(ctx context.Context, monetaryAccountID int, mastercardActionID int, opts *ListOptions) iter.Seq2[NoteTextMasterCardAction, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/mastercard-action/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), mastercardActionID)
 	return listIter[NoteTextMasterCardAction](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextMasterCardActionService) Update(ctx context.Context, monetaryAccountID int, mastercardActionID int, noteTextID int, params NoteTextMasterCardActionUpdateParams) (int, error) {
+func (s *NoteTextMasterCardActionService) Update(ctx context.Context, monetaryAccountID int, mastercardActionID int, noteTextID int, params NoteTextMasterCardActionUpdateParams) (*UpdateResult[NoteTextMasterCardAction], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/mastercard-action/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), mastercardActionID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextMasterCardAction](body, "NoteTextMasterCardAction")
 }
 
 func (s *NoteTextMasterCardActionService) Delete(ctx context.Context, monetaryAccountID int, mastercardActionID int, noteTextID int) error {
@@ -2105,18 +2450,26 @@ func (s *NoteAttachmentOpenBankingMerchantTransactionService) Get(ctx context.Co
 	return unmarshalObject[NoteAttachmentOpenBankingMerchantTransaction](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentOpenBankingMerchantTransactionService) CreateAndFetch(ctx context.Context, monetaryAccountID int, openBankingMerchantTransactionID int, params NoteAttachmentOpenBankingMerchantTransactionCreateParams) (*NoteAttachmentOpenBankingMerchantTransaction, error) {
+	id, err := s.Create(ctx, monetaryAccountID, openBankingMerchantTransactionID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, openBankingMerchantTransactionID, id)
+}
+
 func (s *NoteAttachmentOpenBankingMerchantTransactionService) List(ctx context.Context, monetaryAccountID int, openBankingMerchantTransactionID int, opts *ListOptions) iter.Seq2[NoteAttachmentOpenBankingMerchantTransaction, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/open-banking-merchant-transaction/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), openBankingMerchantTransactionID)
 	return listIter[NoteAttachmentOpenBankingMerchantTransaction](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentOpenBankingMerchantTransactionService) Update(ctx context.Context, monetaryAccountID int, openBankingMerchantTransactionID int, noteAttachmentID int, params NoteAttachmentOpenBankingMerchantTransactionUpdateParams) (int, error) {
+func (s *NoteAttachmentOpenBankingMerchantTransactionService) Update(ctx context.Context, monetaryAccountID int, openBankingMerchantTransactionID int, noteAttachmentID int, params NoteAttachmentOpenBankingMerchantTransactionUpdateParams) (*UpdateResult[NoteAttachmentOpenBankingMerchantTransaction], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/open-banking-merchant-transaction/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), openBankingMerchantTransactionID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentOpenBankingMerchantTransaction](body, "NoteAttachmentOpenBankingMerchantTransaction")
 }
 
 func (s *NoteAttachmentOpenBankingMerchantTransactionService) Delete(ctx context.Context, monetaryAccountID int, openBankingMerchantTransactionID int, noteAttachmentID int) error {
@@ -2144,18 +2497,26 @@ func (s *NoteTextOpenBankingMerchantTransactionService) Get(ctx context.Context,
 	return unmarshalObject[NoteTextOpenBankingMerchantTransaction](body, "NoteText")
 }
 
+func (s *NoteTextOpenBankingMerchantTransactionService) CreateAndFetch(ctx context.Context, monetaryAccountID int, openBankingMerchantTransactionID int, params NoteTextOpenBankingMerchantTransactionCreateParams) (*NoteTextOpenBankingMerchantTransaction, error) {
+	id, err := s.Create(ctx, monetaryAccountID, openBankingMerchantTransactionID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, openBankingMerchantTransactionID, id)
+}
+
 func (s *NoteTextOpenBankingMerchantTransactionService) List(ctx context.Context, monetaryAccountID int, openBankingMerchantTransactionID int, opts *ListOptions) iter.Seq2[NoteTextOpenBankingMerchantTransaction, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/open-banking-merchant-transaction/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), openBankingMerchantTransactionID)
 	return listIter[NoteTextOpenBankingMerchantTransaction](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextOpenBankingMerchantTransactionService) Update(ctx context.Context, monetaryAccountID int, openBankingMerchantTransactionID int, noteTextID int, params NoteTextOpenBankingMerchantTransactionUpdateParams) (int, error) {
+func (s *NoteTextOpenBankingMerchantTransactionService) Update(ctx context.Context, monetaryAccountID int, openBankingMerchantTransactionID int, noteTextID int, params NoteTextOpenBankingMerchantTransactionUpdateParams) (*UpdateResult[NoteTextOpenBankingMerchantTransaction], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/open-banking-merchant-transaction/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), openBankingMerchantTransactionID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextOpenBankingMerchantTransaction](body, "NoteTextOpenBankingMerchantTransaction")
 }
 
 func (s *NoteTextOpenBankingMerchantTransactionService) Delete(ctx context.Context, monetaryAccountID int, openBankingMerchantTransactionID int, noteTextID int) error {
@@ -2183,18 +2544,26 @@ func (s *NoteAttachmentPaymentBatchService) Get(ctx context.Context, monetaryAcc
 	return unmarshalObject[NoteAttachmentPaymentBatch](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentPaymentBatchService) CreateAndFetch(ctx context.Context, monetaryAccountID int, paymentBatchID int, params NoteAttachmentPaymentBatchCreateParams) (*NoteAttachmentPaymentBatch, error) {
+	id, err := s.Create(ctx, monetaryAccountID, paymentBatchID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, paymentBatchID, id)
+}
+
 func (s *NoteAttachmentPaymentBatchService) List(ctx context.Context, monetaryAccountID int, paymentBatchID int, opts *ListOptions) iter.Seq2[NoteAttachmentPaymentBatch, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment-batch/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentBatchID)
 	return listIter[NoteAttachmentPaymentBatch](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentPaymentBatchService) Update(ctx context.Context, monetaryAccountID int, paymentBatchID int, noteAttachmentID int, params NoteAttachmentPaymentBatchUpdateParams) (int, error) {
+func (s *NoteAttachmentPaymentBatchService) Update(ctx context.Context, monetaryAccountID int, paymentBatchID int, noteAttachmentID int, params NoteAttachmentPaymentBatchUpdateParams) (*UpdateResult[NoteAttachmentPaymentBatch], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment-batch/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentBatchID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentPaymentBatch](body, "NoteAttachmentPaymentBatch")
 }
 
 func (s *NoteAttachmentPaymentBatchService) Delete(ctx context.Context, monetaryAccountID int, paymentBatchID int, noteAttachmentID int) error {
@@ -2222,18 +2591,26 @@ func (s *NoteTextPaymentBatchService) Get(ctx context.Context, monetaryAccountID
 	return unmarshalObject[NoteTextPaymentBatch](body, "NoteText")
 }
 
+func (s *NoteTextPaymentBatchService) CreateAndFetch(ctx context.Context, monetaryAccountID int, paymentBatchID int, params NoteTextPaymentBatchCreateParams) (*NoteTextPaymentBatch, error) {
+	id, err := s.Create(ctx, monetaryAccountID, paymentBatchID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, paymentBatchID, id)
+}
+
 func (s *NoteTextPaymentBatchService) List(ctx context.Context, monetaryAccountID int, paymentBatchID int, opts *ListOptions) iter.Seq2[NoteTextPaymentBatch, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment-batch/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentBatchID)
 	return listIter[NoteTextPaymentBatch](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextPaymentBatchService) Update(ctx context.Context, monetaryAccountID int, paymentBatchID int, noteTextID int, params NoteTextPaymentBatchUpdateParams) (int, error) {
+func (s *NoteTextPaymentBatchService) Update(ctx context.Context, monetaryAccountID int, paymentBatchID int, noteTextID int, params NoteTextPaymentBatchUpdateParams) (*UpdateResult[NoteTextPaymentBatch], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment-batch/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentBatchID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextPaymentBatch](body, "NoteTextPaymentBatch")
 }
 
 func (s *NoteTextPaymentBatchService) Delete(ctx context.Context, monetaryAccountID int, paymentBatchID int, noteTextID int) error {
@@ -2261,18 +2638,26 @@ func (s *NoteAttachmentPaymentDelayedService) Get(ctx context.Context, monetaryA
 	return unmarshalObject[NoteAttachmentPaymentDelayed](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentPaymentDelayedService) CreateAndFetch(ctx context.Context, monetaryAccountID int, paymentDelayedID int, params NoteAttachmentPaymentDelayedCreateParams) (*NoteAttachmentPaymentDelayed, error) {
+	id, err := s.Create(ctx, monetaryAccountID, paymentDelayedID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, paymentDelayedID, id)
+}
+
 func (s *NoteAttachmentPaymentDelayedService) List(ctx context.Context, monetaryAccountID int, paymentDelayedID int, opts *ListOptions) iter.Seq2[NoteAttachmentPaymentDelayed, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment-delayed/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentDelayedID)
 	return listIter[NoteAttachmentPaymentDelayed](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentPaymentDelayedService) Update(ctx context.Context, monetaryAccountID int, paymentDelayedID int, noteAttachmentID int, params NoteAttachmentPaymentDelayedUpdateParams) (int, error) {
+func (s *NoteAttachmentPaymentDelayedService) Update(ctx context.Context, monetaryAccountID int, paymentDelayedID int, noteAttachmentID int, params NoteAttachmentPaymentDelayedUpdateParams) (*UpdateResult[NoteAttachmentPaymentDelayed], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment-delayed/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentDelayedID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentPaymentDelayed](body, "NoteAttachmentPaymentDelayed")
 }
 
 func (s *NoteAttachmentPaymentDelayedService) Delete(ctx context.Context, monetaryAccountID int, paymentDelayedID int, noteAttachmentID int) error {
@@ -2300,18 +2685,26 @@ func (s *NoteTextPaymentDelayedService) Get(ctx context.Context, monetaryAccount
 	return unmarshalObject[NoteTextPaymentDelayed](body, "NoteText")
 }
 
+func (s *NoteTextPaymentDelayedService) CreateAndFetch(ctx context.Context, monetaryAccountID int, paymentDelayedID int, params NoteTextPaymentDelayedCreateParams) (*NoteTextPaymentDelayed, error) {
+	id, err := s.Create(ctx, monetaryAccountID, paymentDelayedID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, paymentDelayedID, id)
+}
+
 func (s *NoteTextPaymentDelayedService) List(ctx context.Context, monetaryAccountID int, paymentDelayedID int, opts *ListOptions) iter.Seq2[NoteTextPaymentDelayed, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment-delayed/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentDelayedID)
 	return listIter[NoteTextPaymentDelayed](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextPaymentDelayedService) Update(ctx context.Context, monetaryAccountID int, paymentDelayedID int, noteTextID int, params NoteTextPaymentDelayedUpdateParams) (int, error) {
+func (s *NoteTextPaymentDelayedService) Update(ctx context.Context, monetaryAccountID int, paymentDelayedID int, noteTextID int, params NoteTextPaymentDelayedUpdateParams) (*UpdateResult[NoteTextPaymentDelayed], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment-delayed/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentDelayedID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextPaymentDelayed](body, "NoteTextPaymentDelayed")
 }
 
 func (s *NoteTextPaymentDelayedService) Delete(ctx context.Context, monetaryAccountID int, paymentDelayedID int, noteTextID int) error {
@@ -2339,18 +2732,26 @@ func (s *NoteAttachmentPaymentService) Get(ctx context.Context, monetaryAccountI
 	return unmarshalObject[NoteAttachmentPayment](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentPaymentService) CreateAndFetch(ctx context.Context, monetaryAccountID int, paymentID int, params NoteAttachmentPaymentCreateParams) (*NoteAttachmentPayment, error) {
+	id, err := s.Create(ctx, monetaryAccountID, paymentID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, paymentID, id)
+}
+
 func (s *NoteAttachmentPaymentService) List(ctx context.Context, monetaryAccountID int, paymentID int, opts *ListOptions) iter.Seq2[NoteAttachmentPayment, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentID)
 	return listIter[NoteAttachmentPayment](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentPaymentService) Update(ctx context.Context, monetaryAccountID int, paymentID int, noteAttachmentID int, params NoteAttachmentPaymentUpdateParams) (int, error) {
+func (s *NoteAttachmentPaymentService) Update(ctx context.Context, monetaryAccountID int, paymentID int, noteAttachmentID int, params NoteAttachmentPaymentUpdateParams) (*UpdateResult[NoteAttachmentPayment], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentPayment](body, "NoteAttachmentPayment")
 }
 
 func (s *NoteAttachmentPaymentService) Delete(ctx context.Context, monetaryAccountID int, paymentID int, noteAttachmentID int) error {
@@ -2378,18 +2779,26 @@ func (s *NoteTextPaymentService) Get(ctx context.Context, monetaryAccountID int,
 	return unmarshalObject[NoteTextPayment](body, "NoteText")
 }
 
+func (s *NoteTextPaymentService) CreateAndFetch(ctx context.Context, monetaryAccountID int, paymentID int, params NoteTextPaymentCreateParams) (*NoteTextPayment, error) {
+	id, err := s.Create(ctx, monetaryAccountID, paymentID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, paymentID, id)
+}
+
 func (s *NoteTextPaymentService) List(ctx context.Context, monetaryAccountID int, paymentID int, opts *ListOptions) iter.Seq2[NoteTextPayment, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentID)
 	return listIter[NoteTextPayment](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextPaymentService) Update(ctx context.Context, monetaryAccountID int, paymentID int, noteTextID int, params NoteTextPaymentUpdateParams) (int, error) {
+func (s *NoteTextPaymentService) Update(ctx context.Context, monetaryAccountID int, paymentID int, noteTextID int, params NoteTextPaymentUpdateParams) (*UpdateResult[NoteTextPayment], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextPayment](body, "NoteTextPayment")
 }
 
 func (s *NoteTextPaymentService) Delete(ctx context.Context, monetaryAccountID int, paymentID int, noteTextID int) error {
@@ -2417,18 +2826,26 @@ func (s *NoteAttachmentRequestInquiryBatchService) Get(ctx context.Context, mone
 	return unmarshalObject[NoteAttachmentRequestInquiryBatch](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentRequestInquiryBatchService) CreateAndFetch(ctx context.Context, monetaryAccountID int, requestInquiryBatchID int, params NoteAttachmentRequestInquiryBatchCreateParams) (*NoteAttachmentRequestInquiryBatch, error) {
+	id, err := s.Create(ctx, monetaryAccountID, requestInquiryBatchID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, requestInquiryBatchID, id)
+}
+
 func (s *NoteAttachmentRequestInquiryBatchService) List(ctx context.Context, monetaryAccountID int, requestInquiryBatchID int, opts *ListOptions) iter.Seq2[NoteAttachmentRequestInquiryBatch, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry-batch/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestInquiryBatchID)
 	return listIter[NoteAttachmentRequestInquiryBatch](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentRequestInquiryBatchService) Update(ctx context.Context, monetaryAccountID int, requestInquiryBatchID int, noteAttachmentID int, params NoteAttachmentRequestInquiryBatchUpdateParams) (int, error) {
+func (s *NoteAttachmentRequestInquiryBatchService) Update(ctx context.Context, monetaryAccountID int, requestInquiryBatchID int, noteAttachmentID int, params NoteAttachmentRequestInquiryBatchUpdateParams) (*UpdateResult[NoteAttachmentRequestInquiryBatch], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry-batch/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestInquiryBatchID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentRequestInquiryBatch](body, "NoteAttachmentRequestInquiryBatch")
 }
 
 func (s *NoteAttachmentRequestInquiryBatchService) Delete(ctx context.Context, monetaryAccountID int, requestInquiryBatchID int, noteAttachmentID int) error {
@@ -2456,18 +2873,26 @@ func (s *NoteTextRequestInquiryBatchService) Get(ctx context.Context, monetaryAc
 	return unmarshalObject[NoteTextRequestInquiryBatch](body, "NoteText")
 }
 
+func (s *NoteTextRequestInquiryBatchService) CreateAndFetch(ctx context.Context, monetaryAccountID int, requestInquiryBatchID int, params NoteTextRequestInquiryBatchCreateParams) (*NoteTextRequestInquiryBatch, error) {
+	id, err := s.Create(ctx, monetaryAccountID, requestInquiryBatchID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, requestInquiryBatchID, id)
+}
+
 func (s *NoteTextRequestInquiryBatchService) List(ctx context.Context, monetaryAccountID int, requestInquiryBatchID int, opts *ListOptions) iter.Seq2[NoteTextRequestInquiryBatch, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry-batch/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestInquiryBatchID)
 	return listIter[NoteTextRequestInquiryBatch](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextRequestInquiryBatchService) Update(ctx context.Context, monetaryAccountID int, requestInquiryBatchID int, noteTextID int, params NoteTextRequestInquiryBatchUpdateParams) (int, error) {
+func (s *NoteTextRequestInquiryBatchService) Update(ctx context.Context, monetaryAccountID int, requestInquiryBatchID int, noteTextID int, params NoteTextRequestInquiryBatchUpdateParams) (*UpdateResult[NoteTextRequestInquiryBatch], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry-batch/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestInquiryBatchID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextRequestInquiryBatch](body, "NoteTextRequestInquiryBatch")
 }
 
 func (s *NoteTextRequestInquiryBatchService) Delete(ctx context.Context, monetaryAccountID int, requestInquiryBatchID int, noteTextID int) error {
@@ -2495,18 +2920,26 @@ func (s *NoteAttachmentRequestInquiryService) Get(ctx context.Context, monetaryA
 	return unmarshalObject[NoteAttachmentRequestInquiry](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentRequestInquiryService) CreateAndFetch(ctx context.Context, monetaryAccountID int, requestInquiryID int, params NoteAttachmentRequestInquiryCreateParams) (*NoteAttachmentRequestInquiry, error) {
+	id, err := s.Create(ctx, monetaryAccountID, requestInquiryID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, requestInquiryID, id)
+}
+
 func (s *NoteAttachmentRequestInquiryService) List(ctx context.Context, monetaryAccountID int, requestInquiryID int, opts *ListOptions) iter.Seq2[NoteAttachmentRequestInquiry, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestInquiryID)
 	return listIter[NoteAttachmentRequestInquiry](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentRequestInquiryService) Update(ctx context.Context, monetaryAccountID int, requestInquiryID int, noteAttachmentID int, params NoteAttachmentRequestInquiryUpdateParams) (int, error) {
+func (s *NoteAttachmentRequestInquiryService) Update(ctx context.Context, monetaryAccountID int, requestInquiryID int, noteAttachmentID int, params NoteAttachmentRequestInquiryUpdateParams) (*UpdateResult[NoteAttachmentRequestInquiry], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestInquiryID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentRequestInquiry](body, "NoteAttachmentRequestInquiry")
 }
 
 func (s *NoteAttachmentRequestInquiryService) Delete(ctx context.Context, monetaryAccountID int, requestInquiryID int, noteAttachmentID int) error {
@@ -2534,18 +2967,26 @@ func (s *NoteTextRequestInquiryService) Get(ctx context.Context, monetaryAccount
 	return unmarshalObject[NoteTextRequestInquiry](body, "NoteText")
 }
 
+func (s *NoteTextRequestInquiryService) CreateAndFetch(ctx context.Context, monetaryAccountID int, requestInquiryID int, params NoteTextRequestInquiryCreateParams) (*NoteTextRequestInquiry, error) {
+	id, err := s.Create(ctx, monetaryAccountID, requestInquiryID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, requestInquiryID, id)
+}
+
 func (s *NoteTextRequestInquiryService) List(ctx context.Context, monetaryAccountID int, requestInquiryID int, opts *ListOptions) iter.Seq2[NoteTextRequestInquiry, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestInquiryID)
 	return listIter[NoteTextRequestInquiry](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextRequestInquiryService) Update(ctx context.Context, monetaryAccountID int, requestInquiryID int, noteTextID int, params NoteTextRequestInquiryUpdateParams) (int, error) {
+func (s *NoteTextRequestInquiryService) Update(ctx context.Context, monetaryAccountID int, requestInquiryID int, noteTextID int, params NoteTextRequestInquiryUpdateParams) (*UpdateResult[NoteTextRequestInquiry], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-inquiry/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestInquiryID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextRequestInquiry](body, "NoteTextRequestInquiry")
 }
 
 func (s *NoteTextRequestInquiryService) Delete(ctx context.Context, monetaryAccountID int, requestInquiryID int, noteTextID int) error {
@@ -2573,18 +3014,26 @@ func (s *NoteAttachmentRequestResponseService) Get(ctx context.Context, monetary
 	return unmarshalObject[NoteAttachmentRequestResponse](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentRequestResponseService) CreateAndFetch(ctx context.Context, monetaryAccountID int, requestResponseID int, params NoteAttachmentRequestResponseCreateParams) (*NoteAttachmentRequestResponse, error) {
+	id, err := s.Create(ctx, monetaryAccountID, requestResponseID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, requestResponseID, id)
+}
+
 func (s *NoteAttachmentRequestResponseService) List(ctx context.Context, monetaryAccountID int, requestResponseID int, opts *ListOptions) iter.Seq2[NoteAttachmentRequestResponse, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-response/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestResponseID)
 	return listIter[NoteAttachmentRequestResponse](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentRequestResponseService) Update(ctx context.Context, monetaryAccountID int, requestResponseID int, noteAttachmentID int, params NoteAttachmentRequestResponseUpdateParams) (int, error) {
+func (s *NoteAttachmentRequestResponseService) Update(ctx context.Context, monetaryAccountID int, requestResponseID int, noteAttachmentID int, params NoteAttachmentRequestResponseUpdateParams) (*UpdateResult[NoteAttachmentRequestResponse], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-response/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestResponseID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentRequestResponse](body, "NoteAttachmentRequestResponse")
 }
 
 func (s *NoteAttachmentRequestResponseService) Delete(ctx context.Context, monetaryAccountID int, requestResponseID int, noteAttachmentID int) error {
@@ -2612,18 +3061,26 @@ func (s *NoteTextRequestResponseService) Get(ctx context.Context, monetaryAccoun
 	return unmarshalObject[NoteTextRequestResponse](body, "NoteText")
 }
 
+func (s *NoteTextRequestResponseService) CreateAndFetch(ctx context.Context, monetaryAccountID int, requestResponseID int, params NoteTextRequestResponseCreateParams) (*NoteTextRequestResponse, error) {
+	id, err := s.Create(ctx, monetaryAccountID, requestResponseID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, requestResponseID, id)
+}
+
 func (s *NoteTextRequestResponseService) List(ctx context.Context, monetaryAccountID int, requestResponseID int, opts *ListOptions) iter.Seq2[NoteTextRequestResponse, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-response/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestResponseID)
 	return listIter[NoteTextRequestResponse](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextRequestResponseService) Update(ctx context.Context, monetaryAccountID int, requestResponseID int, noteTextID int, params NoteTextRequestResponseUpdateParams) (int, error) {
+func (s *NoteTextRequestResponseService) Update(ctx context.Context, monetaryAccountID int, requestResponseID int, noteTextID int, params NoteTextRequestResponseUpdateParams) (*UpdateResult[NoteTextRequestResponse], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/request-response/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), requestResponseID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextRequestResponse](body, "NoteTextRequestResponse")
 }
 
 func (s *NoteTextRequestResponseService) Delete(ctx context.Context, monetaryAccountID int, requestResponseID int, noteTextID int) error {
@@ -2651,18 +3108,26 @@ func (s *NoteAttachmentScheduleInstanceService) Get(ctx context.Context, monetar
 	return unmarshalObject[NoteAttachmentScheduleInstance](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentScheduleInstanceService) CreateAndFetch(ctx context.Context, monetaryAccountID int, scheduleID int, scheduleInstanceID int, params NoteAttachmentScheduleInstanceCreateParams) (*NoteAttachmentScheduleInstance, error) {
+	id, err := s.Create(ctx, monetaryAccountID, scheduleID, scheduleInstanceID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, scheduleID, scheduleInstanceID, id)
+}
+
 func (s *NoteAttachmentScheduleInstanceService) List(ctx context.Context, monetaryAccountID int, scheduleID int, scheduleInstanceID int, opts *ListOptions) iter.Seq2[NoteAttachmentScheduleInstance, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule/%d/schedule-instance/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), scheduleID, scheduleInstanceID)
 	return listIter[NoteAttachmentScheduleInstance](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentScheduleInstanceService) Update(ctx context.Context, monetaryAccountID int, scheduleID int, scheduleInstanceID int, noteAttachmentID int, params NoteAttachmentScheduleInstanceUpdateParams) (int, error) {
+func (s *NoteAttachmentScheduleInstanceService) Update(ctx context.Context, monetaryAccountID int, scheduleID int, scheduleInstanceID int, noteAttachmentID int, params NoteAttachmentScheduleInstanceUpdateParams) (*UpdateResult[NoteAttachmentScheduleInstance], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule/%d/schedule-instance/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), scheduleID, scheduleInstanceID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentScheduleInstance](body, "NoteAttachmentScheduleInstance")
 }
 
 func (s *NoteAttachmentScheduleInstanceService) Delete(ctx context.Context, monetaryAccountID int, scheduleID int, scheduleInstanceID int, noteAttachmentID int) error {
@@ -2690,18 +3155,26 @@ func (s *NoteTextScheduleInstanceService) Get(ctx context.Context, monetaryAccou
 	return unmarshalObject[NoteTextScheduleInstance](body, "NoteText")
 }
 
+func (s *NoteTextScheduleInstanceService) CreateAndFetch(ctx context.Context, monetaryAccountID int, scheduleID int, scheduleInstanceID int, params NoteTextScheduleInstanceCreateParams) (*NoteTextScheduleInstance, error) {
+	id, err := s.Create(ctx, monetaryAccountID, scheduleID, scheduleInstanceID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, scheduleID, scheduleInstanceID, id)
+}
+
 func (s *NoteTextScheduleInstanceService) List(ctx context.Context, monetaryAccountID int, scheduleID int, scheduleInstanceID int, opts *ListOptions) iter.Seq2[NoteTextScheduleInstance, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule/%d/schedule-instance/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), scheduleID, scheduleInstanceID)
 	return listIter[NoteTextScheduleInstance](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextScheduleInstanceService) Update(ctx context.Context, monetaryAccountID int, scheduleID int, scheduleInstanceID int, noteTextID int, params NoteTextScheduleInstanceUpdateParams) (int, error) {
+func (s *NoteTextScheduleInstanceService) Update(ctx context.Context, monetaryAccountID int, scheduleID int, scheduleInstanceID int, noteTextID int, params NoteTextScheduleInstanceUpdateParams) (*UpdateResult[NoteTextScheduleInstance], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule/%d/schedule-instance/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), scheduleID, scheduleInstanceID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextScheduleInstance](body, "NoteTextScheduleInstance")
 }
 
 func (s *NoteTextScheduleInstanceService) Delete(ctx context.Context, monetaryAccountID int, scheduleID int, scheduleInstanceID int, noteTextID int) error {
@@ -2729,18 +3202,26 @@ func (s *NoteAttachmentSchedulePaymentBatchService) Get(ctx context.Context, mon
 	return unmarshalObject[NoteAttachmentSchedulePaymentBatch](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentSchedulePaymentBatchService) CreateAndFetch(ctx context.Context, monetaryAccountID int, schedulePaymentBatchID int, params NoteAttachmentSchedulePaymentBatchCreateParams) (*NoteAttachmentSchedulePaymentBatch, error) {
+	id, err := s.Create(ctx, monetaryAccountID, schedulePaymentBatchID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, schedulePaymentBatchID, id)
+}
+
 func (s *NoteAttachmentSchedulePaymentBatchService) List(ctx context.Context, monetaryAccountID int, schedulePaymentBatchID int, opts *ListOptions) iter.Seq2[NoteAttachmentSchedulePaymentBatch, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-payment-batch/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), schedulePaymentBatchID)
 	return listIter[NoteAttachmentSchedulePaymentBatch](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentSchedulePaymentBatchService) Update(ctx context.Context, monetaryAccountID int, schedulePaymentBatchID int, noteAttachmentID int, params NoteAttachmentSchedulePaymentBatchUpdateParams) (int, error) {
+func (s *NoteAttachmentSchedulePaymentBatchService) Update(ctx context.Context, monetaryAccountID int, schedulePaymentBatchID int, noteAttachmentID int, params NoteAttachmentSchedulePaymentBatchUpdateParams) (*UpdateResult[NoteAttachmentSchedulePaymentBatch], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-payment-batch/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), schedulePaymentBatchID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentSchedulePaymentBatch](body, "NoteAttachmentSchedulePaymentBatch")
 }
 
 func (s *NoteAttachmentSchedulePaymentBatchService) Delete(ctx context.Context, monetaryAccountID int, schedulePaymentBatchID int, noteAttachmentID int) error {
@@ -2768,18 +3249,26 @@ func (s *NoteTextSchedulePaymentBatchService) Get(ctx context.Context, monetaryA
 	return unmarshalObject[NoteTextSchedulePaymentBatch](body, "NoteText")
 }
 
+func (s *NoteTextSchedulePaymentBatchService) CreateAndFetch(ctx context.Context, monetaryAccountID int, schedulePaymentBatchID int, params NoteTextSchedulePaymentBatchCreateParams) (*NoteTextSchedulePaymentBatch, error) {
+	id, err := s.Create(ctx, monetaryAccountID, schedulePaymentBatchID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, schedulePaymentBatchID, id)
+}
+
 func (s *NoteTextSchedulePaymentBatchService) List(ctx context.Context, monetaryAccountID int, schedulePaymentBatchID int, opts *ListOptions) iter.Seq2[NoteTextSchedulePaymentBatch, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-payment-batch/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), schedulePaymentBatchID)
 	return listIter[NoteTextSchedulePaymentBatch](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextSchedulePaymentBatchService) Update(ctx context.Context, monetaryAccountID int, schedulePaymentBatchID int, noteTextID int, params NoteTextSchedulePaymentBatchUpdateParams) (int, error) {
+func (s *NoteTextSchedulePaymentBatchService) Update(ctx context.Context, monetaryAccountID int, schedulePaymentBatchID int, noteTextID int, params NoteTextSchedulePaymentBatchUpdateParams) (*UpdateResult[NoteTextSchedulePaymentBatch], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-payment-batch/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), schedulePaymentBatchID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextSchedulePaymentBatch](body, "NoteTextSchedulePaymentBatch")
 }
 
 func (s *NoteTextSchedulePaymentBatchService) Delete(ctx context.Context, monetaryAccountID int, schedulePaymentBatchID int, noteTextID int) error {
@@ -2807,18 +3296,26 @@ func (s *NoteAttachmentSchedulePaymentService) Get(ctx context.Context, monetary
 	return unmarshalObject[NoteAttachmentSchedulePayment](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentSchedulePaymentService) CreateAndFetch(ctx context.Context, monetaryAccountID int, schedulePaymentID int, params NoteAttachmentSchedulePaymentCreateParams) (*NoteAttachmentSchedulePayment, error) {
+	id, err := s.Create(ctx, monetaryAccountID, schedulePaymentID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, schedulePaymentID, id)
+}
+
 func (s *NoteAttachmentSchedulePaymentService) List(ctx context.Context, monetaryAccountID int, schedulePaymentID int, opts *ListOptions) iter.Seq2[NoteAttachmentSchedulePayment, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-payment/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), schedulePaymentID)
 	return listIter[NoteAttachmentSchedulePayment](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentSchedulePaymentService) Update(ctx context.Context, monetaryAccountID int, schedulePaymentID int, noteAttachmentID int, params NoteAttachmentSchedulePaymentUpdateParams) (int, error) {
+func (s *NoteAttachmentSchedulePaymentService) Update(ctx context.Context, monetaryAccountID int, schedulePaymentID int, noteAttachmentID int, params NoteAttachmentSchedulePaymentUpdateParams) (*UpdateResult[NoteAttachmentSchedulePayment], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-payment/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), schedulePaymentID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentSchedulePayment](body, "NoteAttachmentSchedulePayment")
 }
 
 func (s *NoteAttachmentSchedulePaymentService) Delete(ctx context.Context, monetaryAccountID int, schedulePaymentID int, noteAttachmentID int) error {
@@ -2846,18 +3343,26 @@ func (s *NoteTextSchedulePaymentService) Get(ctx context.Context, monetaryAccoun
 	return unmarshalObject[NoteTextSchedulePayment](body, "NoteText")
 }
 
+func (s *NoteTextSchedulePaymentService) CreateAndFetch(ctx context.Context, monetaryAccountID int, schedulePaymentID int, params NoteTextSchedulePaymentCreateParams) (*NoteTextSchedulePayment, error) {
+	id, err := s.Create(ctx, monetaryAccountID, schedulePaymentID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, schedulePaymentID, id)
+}
+
 func (s *NoteTextSchedulePaymentService) List(ctx context.Context, monetaryAccountID int, schedulePaymentID int, opts *ListOptions) iter.Seq2[NoteTextSchedulePayment, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-payment/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), schedulePaymentID)
 	return listIter[NoteTextSchedulePayment](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextSchedulePaymentService) Update(ctx context.Context, monetaryAccountID int, schedulePaymentID int, noteTextID int, params NoteTextSchedulePaymentUpdateParams) (int, error) {
+func (s *NoteTextSchedulePaymentService) Update(ctx context.Context, monetaryAccountID int, schedulePaymentID int, noteTextID int, params NoteTextSchedulePaymentUpdateParams) (*UpdateResult[NoteTextSchedulePayment], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-payment/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), schedulePaymentID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextSchedulePayment](body, "NoteTextSchedulePayment")
 }
 
 func (s *NoteTextSchedulePaymentService) Delete(ctx context.Context, monetaryAccountID int, schedulePaymentID int, noteTextID int) error {
@@ -2885,18 +3390,26 @@ func (s *NoteAttachmentScheduleRequestBatchService) Get(ctx context.Context, mon
 	return unmarshalObject[NoteAttachmentScheduleRequestBatch](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentScheduleRequestBatchService) CreateAndFetch(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryBatchID int, params NoteAttachmentScheduleRequestBatchCreateParams) (*NoteAttachmentScheduleRequestBatch, error) {
+	id, err := s.Create(ctx, monetaryAccountID, scheduleRequestInquiryBatchID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, scheduleRequestInquiryBatchID, id)
+}
+
 func (s *NoteAttachmentScheduleRequestBatchService) List(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryBatchID int, opts *ListOptions) iter.Seq2[NoteAttachmentScheduleRequestBatch, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-request-inquiry-batch/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), scheduleRequestInquiryBatchID)
 	return listIter[NoteAttachmentScheduleRequestBatch](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentScheduleRequestBatchService) Update(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryBatchID int, noteAttachmentID int, params NoteAttachmentScheduleRequestBatchUpdateParams) (int, error) {
+func (s *NoteAttachmentScheduleRequestBatchService) Update(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryBatchID int, noteAttachmentID int, params NoteAttachmentScheduleRequestBatchUpdateParams) (*UpdateResult[NoteAttachmentScheduleRequestBatch], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-request-inquiry-batch/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), scheduleRequestInquiryBatchID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentScheduleRequestBatch](body, "NoteAttachmentScheduleRequestBatch")
 }
 
 func (s *NoteAttachmentScheduleRequestBatchService) Delete(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryBatchID int, noteAttachmentID int) error {
@@ -2924,18 +3437,26 @@ func (s *NoteTextScheduleRequestBatchService) Get(ctx context.Context, monetaryA
 	return unmarshalObject[NoteTextScheduleRequestBatch](body, "NoteText")
 }
 
+func (s *NoteTextScheduleRequestBatchService) CreateAndFetch(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryBatchID int, params NoteTextScheduleRequestBatchCreateParams) (*NoteTextScheduleRequestBatch, error) {
+	id, err := s.Create(ctx, monetaryAccountID, scheduleRequestInquiryBatchID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, scheduleRequestInquiryBatchID, id)
+}
+
 func (s *NoteTextScheduleRequestBatchService) List(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryBatchID int, opts *ListOptions) iter.Seq2[NoteTextScheduleRequestBatch, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-request-inquiry-batch/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), scheduleRequestInquiryBatchID)
 	return listIter[NoteTextScheduleRequestBatch](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextScheduleRequestBatchService) Update(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryBatchID int, noteTextID int, params NoteTextScheduleRequestBatchUpdateParams) (int, error) {
+func (s *NoteTextScheduleRequestBatchService) Update(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryBatchID int, noteTextID int, params NoteTextScheduleRequestBatchUpdateParams) (*UpdateResult[NoteTextScheduleRequestBatch], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-request-inquiry-batch/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), scheduleRequestInquiryBatchID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextScheduleRequestBatch](body, "NoteTextScheduleRequestBatch")
 }
 
 func (s *NoteTextScheduleRequestBatchService) Delete(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryBatchID int, noteTextID int) error {
@@ -2963,18 +3484,26 @@ func (s *NoteAttachmentScheduleRequestService) Get(ctx context.Context, monetary
 	return unmarshalObject[NoteAttachmentScheduleRequest](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentScheduleRequestService) CreateAndFetch(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryID int, params NoteAttachmentScheduleRequestCreateParams) (*NoteAttachmentScheduleRequest, error) {
+	id, err := s.Create(ctx, monetaryAccountID, scheduleRequestInquiryID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, scheduleRequestInquiryID, id)
+}
+
 func (s *NoteAttachmentScheduleRequestService) List(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryID int, opts *ListOptions) iter.Seq2[NoteAttachmentScheduleRequest, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-request-inquiry/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), scheduleRequestInquiryID)
 	return listIter[NoteAttachmentScheduleRequest](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentScheduleRequestService) Update(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryID int, noteAttachmentID int, params NoteAttachmentScheduleRequestUpdateParams) (int, error) {
+func (s *NoteAttachmentScheduleRequestService) Update(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryID int, noteAttachmentID int, params NoteAttachmentScheduleRequestUpdateParams) (*UpdateResult[NoteAttachmentScheduleRequest], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-request-inquiry/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), scheduleRequestInquiryID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentScheduleRequest](body, "NoteAttachmentScheduleRequest")
 }
 
 func (s *NoteAttachmentScheduleRequestService) Delete(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryID int, noteAttachmentID int) error {
@@ -3002,18 +3531,26 @@ func (s *NoteTextScheduleRequestService) Get(ctx context.Context, monetaryAccoun
 	return unmarshalObject[NoteTextScheduleRequest](body, "NoteText")
 }
 
+func (s *NoteTextScheduleRequestService) CreateAndFetch(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryID int, params NoteTextScheduleRequestCreateParams) (*NoteTextScheduleRequest, error) {
+	id, err := s.Create(ctx, monetaryAccountID, scheduleRequestInquiryID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, scheduleRequestInquiryID, id)
+}
+
 func (s *NoteTextScheduleRequestService) List(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryID int, opts *ListOptions) iter.Seq2[NoteTextScheduleRequest, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-request-inquiry/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), scheduleRequestInquiryID)
 	return listIter[NoteTextScheduleRequest](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextScheduleRequestService) Update(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryID int, noteTextID int, params NoteTextScheduleRequestUpdateParams) (int, error) {
+func (s *NoteTextScheduleRequestService) Update(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryID int, noteTextID int, params NoteTextScheduleRequestUpdateParams) (*UpdateResult[NoteTextScheduleRequest], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/schedule-request-inquiry/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), scheduleRequestInquiryID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextScheduleRequest](body, "NoteTextScheduleRequest")
 }
 
 func (s *NoteTextScheduleRequestService) Delete(ctx context.Context, monetaryAccountID int, scheduleRequestInquiryID int, noteTextID int) error {
@@ -3041,18 +3578,26 @@ func (s *NoteAttachmentSofortMerchantTransactionService) Get(ctx context.Context
 	return unmarshalObject[NoteAttachmentSofortMerchantTransaction](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentSofortMerchantTransactionService) CreateAndFetch(ctx context.Context, monetaryAccountID int, sofortMerchantTransactionID int, params NoteAttachmentSofortMerchantTransactionCreateParams) (*NoteAttachmentSofortMerchantTransaction, error) {
+	id, err := s.Create(ctx, monetaryAccountID, sofortMerchantTransactionID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, sofortMerchantTransactionID, id)
+}
+
 func (s *NoteAttachmentSofortMerchantTransactionService) List(ctx context.Context, monetaryAccountID int, sofortMerchantTransactionID int, opts *ListOptions) iter.Seq2[NoteAttachmentSofortMerchantTransaction, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/sofort-merchant-transaction/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), sofortMerchantTransactionID)
 	return listIter[NoteAttachmentSofortMerchantTransaction](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentSofortMerchantTransactionService) Update(ctx context.Context, monetaryAccountID int, sofortMerchantTransactionID int, noteAttachmentID int, params NoteAttachmentSofortMerchantTransactionUpdateParams) (int, error) {
+func (s *NoteAttachmentSofortMerchantTransactionService) Update(ctx context.Context, monetaryAccountID int, sofortMerchantTransactionID int, noteAttachmentID int, params NoteAttachmentSofortMerchantTransactionUpdateParams) (*UpdateResult[NoteAttachmentSofortMerchantTransaction], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/sofort-merchant-transaction/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), sofortMerchantTransactionID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentSofortMerchantTransaction](body, "NoteAttachmentSofortMerchantTransaction")
 }
 
 func (s *NoteAttachmentSofortMerchantTransactionService) Delete(ctx context.Context, monetaryAccountID int, sofortMerchantTransactionID int, noteAttachmentID int) error {
@@ -3080,18 +3625,26 @@ func (s *NoteTextSofortMerchantTransactionService) Get(ctx context.Context, mone
 	return unmarshalObject[NoteTextSofortMerchantTransaction](body, "NoteText")
 }
 
+func (s *NoteTextSofortMerchantTransactionService) CreateAndFetch(ctx context.Context, monetaryAccountID int, sofortMerchantTransactionID int, params NoteTextSofortMerchantTransactionCreateParams) (*NoteTextSofortMerchantTransaction, error) {
+	id, err := s.Create(ctx, monetaryAccountID, sofortMerchantTransactionID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, sofortMerchantTransactionID, id)
+}
+
 func (s *NoteTextSofortMerchantTransactionService) List(ctx context.Context, monetaryAccountID int, sofortMerchantTransactionID int, opts *ListOptions) iter.Seq2[NoteTextSofortMerchantTransaction, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/sofort-merchant-transaction/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), sofortMerchantTransactionID)
 	return listIter[NoteTextSofortMerchantTransaction](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextSofortMerchantTransactionService) Update(ctx context.Context, monetaryAccountID int, sofortMerchantTransactionID int, noteTextID int, params NoteTextSofortMerchantTransactionUpdateParams) (int, error) {
+func (s *NoteTextSofortMerchantTransactionService) Update(ctx context.Context, monetaryAccountID int, sofortMerchantTransactionID int, noteTextID int, params NoteTextSofortMerchantTransactionUpdateParams) (*UpdateResult[NoteTextSofortMerchantTransaction], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/sofort-merchant-transaction/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), sofortMerchantTransactionID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextSofortMerchantTransaction](body, "NoteTextSofortMerchantTransaction")
 }
 
 func (s *NoteTextSofortMerchantTransactionService) Delete(ctx context.Context, monetaryAccountID int, sofortMerchantTransactionID int, noteTextID int) error {
@@ -3119,18 +3672,26 @@ func (s *NoteAttachmentWhitelistResultService) Get(ctx context.Context, monetary
 	return unmarshalObject[NoteAttachmentWhitelistResult](body, "NoteAttachment")
 }
 
+func (s *NoteAttachmentWhitelistResultService) CreateAndFetch(ctx context.Context, monetaryAccountID int, whitelistID int, whitelistResultID int, params NoteAttachmentWhitelistResultCreateParams) (*NoteAttachmentWhitelistResult, error) {
+	id, err := s.Create(ctx, monetaryAccountID, whitelistID, whitelistResultID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, whitelistID, whitelistResultID, id)
+}
+
 func (s *NoteAttachmentWhitelistResultService) List(ctx context.Context, monetaryAccountID int, whitelistID int, whitelistResultID int, opts *ListOptions) iter.Seq2[NoteAttachmentWhitelistResult, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/whitelist/%d/whitelist-result/%d/note-attachment", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), whitelistID, whitelistResultID)
 	return listIter[NoteAttachmentWhitelistResult](s.client, ctx, path, "NoteAttachment", opts)
 }
 
-func (s *NoteAttachmentWhitelistResultService) Update(ctx context.Context, monetaryAccountID int, whitelistID int, whitelistResultID int, noteAttachmentID int, params NoteAttachmentWhitelistResultUpdateParams) (int, error) {
+func (s *NoteAttachmentWhitelistResultService) Update(ctx context.Context, monetaryAccountID int, whitelistID int, whitelistResultID int, noteAttachmentID int, params NoteAttachmentWhitelistResultUpdateParams) (*UpdateResult[NoteAttachmentWhitelistResult], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/whitelist/%d/whitelist-result/%d/note-attachment/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), whitelistID, whitelistResultID, noteAttachmentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteAttachmentWhitelistResult](body, "NoteAttachmentWhitelistResult")
 }
 
 func (s *NoteAttachmentWhitelistResultService) Delete(ctx context.Context, monetaryAccountID int, whitelistID int, whitelistResultID int, noteAttachmentID int) error {
@@ -3158,18 +3719,26 @@ func (s *NoteTextWhitelistResultService) Get(ctx context.Context, monetaryAccoun
 	return unmarshalObject[NoteTextWhitelistResult](body, "NoteText")
 }
 
+func (s *NoteTextWhitelistResultService) CreateAndFetch(ctx context.Context, monetaryAccountID int, whitelistID int, whitelistResultID int, params NoteTextWhitelistResultCreateParams) (*NoteTextWhitelistResult, error) {
+	id, err := s.Create(ctx, monetaryAccountID, whitelistID, whitelistResultID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, whitelistID, whitelistResultID, id)
+}
+
 func (s *NoteTextWhitelistResultService) List(ctx context.Context, monetaryAccountID int, whitelistID int, whitelistResultID int, opts *ListOptions) iter.Seq2[NoteTextWhitelistResult, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/whitelist/%d/whitelist-result/%d/note-text", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), whitelistID, whitelistResultID)
 	return listIter[NoteTextWhitelistResult](s.client, ctx, path, "NoteText", opts)
 }
 
-func (s *NoteTextWhitelistResultService) Update(ctx context.Context, monetaryAccountID int, whitelistID int, whitelistResultID int, noteTextID int, params NoteTextWhitelistResultUpdateParams) (int, error) {
+func (s *NoteTextWhitelistResultService) Update(ctx context.Context, monetaryAccountID int, whitelistID int, whitelistResultID int, noteTextID int, params NoteTextWhitelistResultUpdateParams) (*UpdateResult[NoteTextWhitelistResult], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/whitelist/%d/whitelist-result/%d/note-text/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), whitelistID, whitelistResultID, noteTextID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[NoteTextWhitelistResult](body, "NoteTextWhitelistResult")
 }
 
 func (s *NoteTextWhitelistResultService) Delete(ctx context.Context, monetaryAccountID int, whitelistID int, whitelistResultID int, noteTextID int) error {
@@ -3284,13 +3853,13 @@ func (s *UserPersonService) Get(ctx context.Context, userPersonID int) (*UserPer
 	return unmarshalObject[UserPerson](body, "UserPerson")
 }
 
-func (s *UserPersonService) Update(ctx context.Context, userPersonID int, params UserPersonUpdateParams) (int, error) {
+func (s *UserPersonService) Update(ctx context.Context, userPersonID int, params UserPersonUpdateParams) (*UpdateResult[UserPerson], error) {
 	path := fmt.Sprintf("user-person/%d", userPersonID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[UserPerson](body, "UserPerson")
 }
 
 type UserPaymentServiceProviderService struct{ *service }
@@ -3324,18 +3893,26 @@ func (s *OauthCallbackUrlService) Get(ctx context.Context, oAuthClientID int, ca
 	return unmarshalObject[OauthCallbackUrl](body, "OauthCallbackUrl")
 }
 
+func (s *OauthCallbackUrlService) CreateAndFetch(ctx context.Context, oAuthClientID int, params OauthCallbackUrlCreateParams) (*OauthCallbackUrl, error) {
+	id, err := s.Create(ctx, oAuthClientID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), oAuthClientID, id)
+}
+
 func (s *OauthCallbackUrlService) List(ctx context.Context, oAuthClientID int, opts *ListOptions) iter.Seq2[OauthCallbackUrl, error] {
 	path := fmt.Sprintf("user/%d/oauth-client/%d/callback-url", s.client.userID, oAuthClientID)
 	return listIter[OauthCallbackUrl](s.client, ctx, path, "OauthCallbackUrl", opts)
 }
 
-func (s *OauthCallbackUrlService) Update(ctx context.Context, oAuthClientID int, callbackURLID int, params OauthCallbackUrlUpdateParams) (int, error) {
+func (s *OauthCallbackUrlService) Update(ctx context.Context, oAuthClientID int, callbackURLID int, params OauthCallbackUrlUpdateParams) (*UpdateResult[OauthCallbackUrl], error) {
 	path := fmt.Sprintf("user/%d/oauth-client/%d/callback-url/%d", s.client.userID, oAuthClientID, callbackURLID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[OauthCallbackUrl](body, "OauthCallbackUrl")
 }
 
 func (s *OauthCallbackUrlService) Delete(ctx context.Context, oAuthClientID int, callbackURLID int) error {
@@ -3363,18 +3940,26 @@ func (s *OauthClientService) Get(ctx context.Context, oAuthClientID int) (*Oauth
 	return unmarshalObject[OauthClient](body, "OauthClient")
 }
 
+func (s *OauthClientService) CreateAndFetch(ctx context.Context, params OauthClientCreateParams) (*OauthClient, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *OauthClientService) List(ctx context.Context, opts *ListOptions) iter.Seq2[OauthClient, error] {
 	path := fmt.Sprintf("user/%d/oauth-client", s.client.userID)
 	return listIter[OauthClient](s.client, ctx, path, "OauthClient", opts)
 }
 
-func (s *OauthClientService) Update(ctx context.Context, oAuthClientID int, params OauthClientUpdateParams) (int, error) {
+func (s *OauthClientService) Update(ctx context.Context, oAuthClientID int, params OauthClientUpdateParams) (*UpdateResult[OauthClient], error) {
 	path := fmt.Sprintf("user/%d/oauth-client/%d", s.client.userID, oAuthClientID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[OauthClient](body, "OauthClient")
 }
 
 type PaymentAutoAllocateDefinitionService struct{ *service }
@@ -3404,18 +3989,26 @@ func (s *PaymentAutoAllocateService) Get(ctx context.Context, monetaryAccountID
 	return unmarshalObject[PaymentAutoAllocate](body, "PaymentAutoAllocate")
 }
 
+func (s *PaymentAutoAllocateService) CreateAndFetch(ctx context.Context, monetaryAccountID int, params PaymentAutoAllocateCreateParams) (*PaymentAutoAllocate, error) {
+	id, err := s.Create(ctx, monetaryAccountID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), monetaryAccountID, id)
+}
+
 func (s *PaymentAutoAllocateService) List(ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[PaymentAutoAllocate, error] {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment-auto-allocate", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
 	return listIter[PaymentAutoAllocate](s.client, ctx, path, "PaymentAutoAllocate", opts)
 }
 
-func (s *PaymentAutoAllocateService) Update(ctx context.Context, monetaryAccountID int, paymentAutoAllocateID int, params PaymentAutoAllocateUpdateParams) (int, error) {
+func (s *PaymentAutoAllocateService) Update(ctx context.Context, monetaryAccountID int, paymentAutoAllocateID int, params PaymentAutoAllocateUpdateParams) (*UpdateResult[PaymentAutoAllocate], error) {
 	path := fmt.Sprintf("user/%d/monetary-account/%d/payment-auto-allocate/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), paymentAutoAllocateID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[PaymentAutoAllocate](body, "PaymentAutoAllocate")
 }
 
 func (s *PaymentAutoAllocateService) Delete(ctx context.Context, monetaryAccountID int, paymentAutoAllocateID int) error {
@@ -3450,6 +4043,14 @@ func (s *PaymentServiceProviderCredentialService) Get(ctx context.Context, payme
 	return unmarshalObject[PaymentServiceProviderCredential](body, "CredentialPasswordIp")
 }
 
+func (s *PaymentServiceProviderCredentialService) CreateAndFetch(ctx context.Context, params PaymentServiceProviderCredentialCreateParams) (*PaymentServiceProviderCredential, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 type PaymentServiceProviderDraftPaymentService struct{ *service }
 
 func (s *PaymentServiceProviderDraftPaymentService) Create(ctx context.Context, params PaymentServiceProviderDraftPaymentCreateParams) (int, error) {
@@ -3470,18 +4071,26 @@ func (s *PaymentServiceProviderDraftPaymentService) Get(ctx context.Context, pay
 	return unmarshalObject[PaymentServiceProviderDraftPayment](body, "PaymentServiceProviderDraftPayment")
 }
 
+func (s *PaymentServiceProviderDraftPaymentService) CreateAndFetch(ctx context.Context, params PaymentServiceProviderDraftPaymentCreateParams) (*PaymentServiceProviderDraftPayment, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *PaymentServiceProviderDraftPaymentService) List(ctx context.Context, opts *ListOptions) iter.Seq2[PaymentServiceProviderDraftPayment, error] {
 	path := fmt.Sprintf("user/%d/payment-service-provider-draft-payment", s.client.userID)
 	return listIter[PaymentServiceProviderDraftPayment](s.client, ctx, path, "PaymentServiceProviderDraftPayment", opts)
 }
 
-func (s *PaymentServiceProviderDraftPaymentService) Update(ctx context.Context, paymentServiceProviderDraftPaymentID int, params PaymentServiceProviderDraftPaymentUpdateParams) (int, error) {
+func (s *PaymentServiceProviderDraftPaymentService) Update(ctx context.Context, paymentServiceProviderDraftPaymentID int, params PaymentServiceProviderDraftPaymentUpdateParams) (*UpdateResult[PaymentServiceProviderDraftPayment], error) {
 	path := fmt.Sprintf("user/%d/payment-service-provider-draft-payment/%d", s.client.userID, paymentServiceProviderDraftPaymentID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[PaymentServiceProviderDraftPayment](body, "PaymentServiceProviderDraftPayment")
 }
 
 type PaymentServiceProviderIssuerTransactionService struct{ *service }
@@ -3504,18 +4113,26 @@ func (s *PaymentServiceProviderIssuerTransactionService) Get(ctx context.Context
 	return unmarshalObject[PaymentServiceProviderIssuerTransaction](body, "PaymentServiceProviderIssuerTransaction")
 }
 
+func (s *PaymentServiceProviderIssuerTransactionService) CreateAndFetch(ctx context.Context, params PaymentServiceProviderIssuerTransactionCreateParams) (*PaymentServiceProviderIssuerTransaction, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *PaymentServiceProviderIssuerTransactionService) List(ctx context.Context, opts *ListOptions) iter.Seq2[PaymentServiceProviderIssuerTransaction, error] {
 	path := fmt.Sprintf("user/%d/payment-service-provider-issuer-transaction", s.client.userID)
 	return listIter[PaymentServiceProviderIssuerTransaction](s.client, ctx, path, "PaymentServiceProviderIssuerTransaction", opts)
 }
 
-func (s *PaymentServiceProviderIssuerTransactionService) Update(ctx context.Context, paymentServiceProviderIssuerTransactionID int, params PaymentServiceProviderIssuerTransactionUpdateParams) (int, error) {
+func (s *PaymentServiceProviderIssuerTransactionService) Update(ctx context.Context, paymentServiceProviderIssuerTransactionID int, params PaymentServiceProviderIssuerTransactionUpdateParams) (*UpdateResult[PaymentServiceProviderIssuerTransaction], error) {
 	path := fmt.Sprintf("user/%d/payment-service-provider-issuer-transaction/%d", s.client.userID, paymentServiceProviderIssuerTransactionID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[PaymentServiceProviderIssuerTransaction](body, "PaymentServiceProviderIssuerTransaction")
 }
 
 type PermittedIpService struct{ *service }
@@ -3538,18 +4155,26 @@ func (s *PermittedIpService) Get(ctx context.Context, credentialPasswordIPID int
 	return unmarshalObject[PermittedIp](body, "PermittedIp")
 }
 
+func (s *PermittedIpService) CreateAndFetch(ctx context.Context, credentialPasswordIPID int, params PermittedIpCreateParams) (*PermittedIp, error) {
+	id, err := s.Create(ctx, credentialPasswordIPID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), credentialPasswordIPID, id)
+}
+
 func (s *PermittedIpService) List(ctx context.Context, credentialPasswordIPID int, opts *ListOptions) iter.Seq2[PermittedIp, error] {
 	path := fmt.Sprintf("user/%d/credential-password-ip/%d/ip", s.client.userID, credentialPasswordIPID)
 	return listIter[PermittedIp](s.client, ctx, path, "PermittedIp", opts)
 }
 
-func (s *PermittedIpService) Update(ctx context.Context, credentialPasswordIPID int, ipID int, params PermittedIpUpdateParams) (int, error) {
+func (s *PermittedIpService) Update(ctx context.Context, credentialPasswordIPID int, ipID int, params PermittedIpUpdateParams) (*UpdateResult[PermittedIp], error) {
 	path := fmt.Sprintf("user/%d/credential-password-ip/%d/ip/%d", s.client.userID, credentialPasswordIPID, ipID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[PermittedIp](body, "PermittedIp")
 }
 
 type SandboxUserCompanyService struct{ *service }
@@ -3630,6 +4255,14 @@ func (s *TransferwiseAccountQuoteService) Get(ctx context.Context, transferwiseQ
 	return unmarshalObject[TransferwiseAccountQuote](body, "TransferwiseRecipient")
 }
 
+func (s *TransferwiseAccountQuoteService) CreateAndFetch(ctx context.Context, transferwiseQuoteID int, params TransferwiseAccountQuoteCreateParams) (*TransferwiseAccountQuote, error) {
+	id, err := s.Create(ctx, transferwiseQuoteID, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), transferwiseQuoteID, id)
+}
+
 func (s *TransferwiseAccountQuoteService) List(ctx context.Context, transferwiseQuoteID int, opts *ListOptions) iter.Seq2[TransferwiseAccountQuote, error] {
 	path := fmt.Sprintf("user/%d/transferwise-quote/%d/transferwise-recipient", s.client.userID, transferwiseQuoteID)
 	return listIter[TransferwiseAccountQuote](s.client, ctx, path, "TransferwiseRecipient", opts)
@@ -3683,6 +4316,14 @@ func (s *TransferwiseQuoteTemporaryService) Get(ctx context.Context, transferwis
 	return unmarshalObject[TransferwiseQuoteTemporary](body, "TransferwiseQuote")
 }
 
+func (s *TransferwiseQuoteTemporaryService) CreateAndFetch(ctx context.Context, params TransferwiseQuoteTemporaryCreateParams) (*TransferwiseQuoteTemporary, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 type TransferwiseTransferRequirementService struct{ *service }
 
 func (s *TransferwiseTransferRequirementService) Create(ctx context.Context, transferwiseQuoteID int, params TransferwiseTransferRequirementCreateParams) (int, error) {
@@ -3767,18 +4408,26 @@ func (s *WhitelistSddOneOffService) Get(ctx context.Context, whitelistSDDOneOffI
 	return unmarshalObject[WhitelistSddOneOff](body, "WhitelistSddOneOff")
 }
 
+func (s *WhitelistSddOneOffService) CreateAndFetch(ctx context.Context, params WhitelistSddOneOffCreateParams) (*WhitelistSddOneOff, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *WhitelistSddOneOffService) List(ctx context.Context, opts *ListOptions) iter.Seq2[WhitelistSddOneOff, error] {
 	path := fmt.Sprintf("user/%d/whitelist-sdd-one-off", s.client.userID)
 	return listIter[WhitelistSddOneOff](s.client, ctx, path, "WhitelistSddOneOff", opts)
 }
 
-func (s *WhitelistSddOneOffService) Update(ctx context.Context, whitelistSDDOneOffID int, params WhitelistSddOneOffUpdateParams) (int, error) {
+func (s *WhitelistSddOneOffService) Update(ctx context.Context, whitelistSDDOneOffID int, params WhitelistSddOneOffUpdateParams) (*UpdateResult[WhitelistSddOneOff], error) {
 	path := fmt.Sprintf("user/%d/whitelist-sdd-one-off/%d", s.client.userID, whitelistSDDOneOffID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[WhitelistSddOneOff](body, "WhitelistSddOneOff")
 }
 
 func (s *WhitelistSddOneOffService) Delete(ctx context.Context, whitelistSDDOneOffID int) error {
@@ -3806,18 +4455,26 @@ func (s *WhitelistSddRecurringService) Get(ctx context.Context, whitelistSDDRecu
 	return unmarshalObject[WhitelistSddRecurring](body, "WhitelistSddRecurring")
 }
 
+func (s *WhitelistSddRecurringService) CreateAndFetch(ctx context.Context, params WhitelistSddRecurringCreateParams) (*WhitelistSddRecurring, error) {
+	id, err := s.Create(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(withoutIdempotencyKey(ctx), id)
+}
+
 func (s *WhitelistSddRecurringService) List(ctx context.Context, opts *ListOptions) iter.Seq2[WhitelistSddRecurring, error] {
 	path := fmt.Sprintf("user/%d/whitelist-sdd-recurring", s.client.userID)
 	return listIter[WhitelistSddRecurring](s.client, ctx, path, "WhitelistSddRecurring", opts)
 }
 
-func (s *WhitelistSddRecurringService) Update(ctx context.Context, whitelistSDDRecurringID int, params WhitelistSddRecurringUpdateParams) (int, error) {
+func (s *WhitelistSddRecurringService) Update(ctx context.Context, whitelistSDDRecurringID int, params WhitelistSddRecurringUpdateParams) (*UpdateResult[WhitelistSddRecurring], error) {
 	path := fmt.Sprintf("user/%d/whitelist-sdd-recurring/%d", s.client.userID, whitelistSDDRecurringID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[WhitelistSddRecurring](body, "WhitelistSddRecurring")
 }
 
 func (s *WhitelistSddRecurringService) Delete(ctx context.Context, whitelistSDDRecurringID int) error {
@@ -3875,13 +4532,13 @@ func (s *MasterCardIdentityCheckChallengeRequestUserService) Get(ctx context.Con
 	return unmarshalObject[MasterCardIdentityCheckChallengeRequestUser](body, "MasterCardIdentityCheckChallengeRequest")
 }
 
-func (s *MasterCardIdentityCheckChallengeRequestUserService) Update(ctx context.Context, challengeRequestID int, params MasterCardIdentityCheckChallengeRequestUserUpdateParams) (int, error) {
+func (s *MasterCardIdentityCheckChallengeRequestUserService) Update(ctx context.Context, challengeRequestID int, params MasterCardIdentityCheckChallengeRequestUserUpdateParams) (*UpdateResult[MasterCardIdentityCheckChallengeRequestUser], error) {
 	path := fmt.Sprintf("user/%d/challenge-request/%d", s.client.userID, challengeRequestID)
 	body, _, err := s.client.put(ctx, path, params)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	return unmarshalID(body)
+	return unmarshalUpdate[MasterCardIdentityCheckChallengeRequestUser](body, "MasterCardIdentityCheckChallengeRequestUser")
 }
 
 type HealthCheckService struct{ *service }
@@ -4229,3 +4886,586 @@ func (c *Client) initServices() {
 	c.MasterCardIdentityCheckChallengeRequestUser = &MasterCardIdentityCheckChallengeRequestUserService{&c.common}
 	c.HealthCheck = &HealthCheckService{&c.common}
 }
+
+var endpointRegistry = map[string]EndpointMeta{
+	"BillingContractSubscription": {List: "user/{}/billing-contract-subscription"},
+	"CustomerLimit": {List: "user/{}/limit"},
+	"InvoiceExportPdf": {Create: "user/{}/invoice/{}/invoice-export", Read: "user/{}/invoice/{}/invoice-export/{}", Update: "user/{}/invoice/{}/invoice-export/{}", Delete: "user/{}/invoice/{}/invoice-export/{}"},
+	"InvoiceExportPdfContent": {List: "user/{}/invoice/{}/pdf-content"},
+	"Invoice": {Read: "user/{}/monetary-account/{}/invoice/{}", List: "user/{}/monetary-account/{}/invoice"},
+	"InvoiceByUser": {Read: "user/{}/invoice/{}", List: "user/{}/invoice"},
+	"AdditionalTransactionInformationCategory": {List: "user/{}/additional-transaction-information-category"},
+	"AdditionalTransactionInformationCategoryUserDefined": {Create: "user/{}/additional-transaction-information-category-user-defined"},
+	"AttachmentConversationContent": {List: "user/{}/chat-conversation/{}/attachment/{}/content"},
+	"AttachmentMonetaryAccountContent": {List: "user/{}/monetary-account/{}/attachment/{}/content"},
+	"AttachmentPublicContent": {List: "attachment-public/{}/content"},
+	"AttachmentUserContent": {List: "user/{}/attachment/{}/content"},
+	"AttachmentMonetaryAccount": {Create: "user/{}/monetary-account/{}/attachment"},
+	"AttachmentPublic": {Create: "attachment-public", Read: "attachment-public/{}"},
+	"AttachmentUser": {Read: "user/{}/attachment/{}"},
+	"Avatar": {Create: "avatar", Read: "avatar/{}"},
+	"BankSwitchServiceNetherlandsIncomingPayment": {Read: "user/{}/monetary-account/{}/switch-service-payment/{}"},
+	"Payment": {Create: "user/{}/monetary-account/{}/payment", Read: "user/{}/monetary-account/{}/payment/{}", List: "user/{}/monetary-account/{}/payment"},
+	"PaymentAutoAllocateInstance": {Read: "user/{}/monetary-account/{}/payment-auto-allocate/{}/instance/{}", List: "user/{}/monetary-account/{}/payment-auto-allocate/{}/instance"},
+	"PaymentBatch": {Create: "user/{}/monetary-account/{}/payment-batch", Read: "user/{}/monetary-account/{}/payment-batch/{}", List: "user/{}/monetary-account/{}/payment-batch", Update: "user/{}/monetary-account/{}/payment-batch/{}"},
+	"BunqMeFundraiserProfileUser": {Read: "user/{}/bunqme-fundraiser-profile/{}", List: "user/{}/bunqme-fundraiser-profile"},
+	"BunqMeFundraiserResult": {Read: "user/{}/monetary-account/{}/bunqme-fundraiser-result/{}"},
+	"BunqMeTabResultResponse": {Read: "user/{}/monetary-account/{}/bunqme-tab-result-response/{}"},
+	"BunqMeTab": {Create: "user/{}/monetary-account/{}/bunqme-tab", Read: "user/{}/monetary-account/{}/bunqme-tab/{}", List: "user/{}/monetary-account/{}/bunqme-tab", Update: "user/{}/monetary-account/{}/bunqme-tab/{}"},
+	"CardBatchReplace": {Create: "user/{}/card-batch-replace"},
+	"CardBatch": {Create: "user/{}/card-batch"},
+	"CardCredit": {Create: "user/{}/card-credit"},
+	"CardGeneratedCvc2": {Create: "user/{}/card/{}/generated-cvc2", Read: "user/{}/card/{}/generated-cvc2/{}", List: "user/{}/card/{}/generated-cvc2", Update: "user/{}/card/{}/generated-cvc2/{}"},
+	"CardDebit": {Create: "user/{}/card-debit"},
+	"CardName": {List: "user/{}/card-name"},
+	"CardReplace": {Create: "user/{}/card/{}/replace"},
+	"Card": {Read: "user/{}/card/{}", List: "user/{}/card", Update: "user/{}/card/{}"},
+	"CertificatePinned": {Create: "user/{}/certificate-pinned", Read: "user/{}/certificate-pinned/{}", List: "user/{}/certificate-pinned", Delete: "user/{}/certificate-pinned/{}"},
+	"CompanyEmployeeSettingAdyenCardTransaction": {Read: "user/{}/company-employee-setting-adyen-card-transaction/{}"},
+	"Company": {Create: "user/{}/company", Read: "user/{}/company/{}", List: "user/{}/company", Update: "user/{}/company/{}"},
+	"UserCompany": {Read: "user-company/{}", Update: "user-company/{}"},
+	"ConfirmationOfFunds": {Create: "user/{}/confirmation-of-funds"},
+	"CurrencyCloudBeneficiaryRequirement": {List: "user/{}/currency-cloud-beneficiary-requirement"},
+	"CurrencyCloudBeneficiary": {Create: "user/{}/currency-cloud-beneficiary", Read: "user/{}/currency-cloud-beneficiary/{}", List: "user/{}/currency-cloud-beneficiary"},
+	"CurrencyCloudPaymentQuote": {Create: "user/{}/monetary-account/{}/currency-cloud-payment-quote"},
+	"CurrencyConversionQuote": {Create: "user/{}/monetary-account/{}/currency-conversion-quote", Read: "user/{}/monetary-account/{}/currency-conversion-quote/{}", Update: "user/{}/monetary-account/{}/currency-conversion-quote/{}"},
+	"CurrencyConversion": {Read: "user/{}/monetary-account/{}/currency-conversion/{}", List: "user/{}/monetary-account/{}/currency-conversion"},
+	"DeviceServer": {Create: "device-server", Read: "device-server/{}", List: "device-server"},
+	"Device": {Read: "device/{}", List: "device"},
+	"DraftPayment": {Create: "user/{}/monetary-account/{}/draft-payment", Read: "user/{}/monetary-account/{}/draft-payment/{}", List: "user/{}/monetary-account/{}/draft-payment", Update: "user/{}/monetary-account/{}/draft-payment/{}"},
+	"Schedule": {Read: "user/{}/monetary-account/{}/schedule/{}", List: "user/{}/monetary-account/{}/schedule"},
+	"ServerError": {Create: "server-error"},
+	"Event": {Read: "user/{}/event/{}", List: "user/{}/event"},
+	"FeatureAnnouncement": {Read: "user/{}/feature-announcement/{}"},
+	"IdealMerchantTransaction": {Create: "user/{}/monetary-account/{}/ideal-merchant-transaction", Read: "user/{}/monetary-account/{}/ideal-merchant-transaction/{}", List: "user/{}/monetary-account/{}/ideal-merchant-transaction"},
+	"SchedulePayment": {Create: "user/{}/monetary-account/{}/schedule-payment", Read: "user/{}/monetary-account/{}/schedule-payment/{}", List: "user/{}/monetary-account/{}/schedule-payment", Update: "user/{}/monetary-account/{}/schedule-payment/{}", Delete: "user/{}/monetary-account/{}/schedule-payment/{}"},
+	"SchedulePaymentBatch": {Create: "user/{}/monetary-account/{}/schedule-payment-batch", Read: "user/{}/monetary-account/{}/schedule-payment-batch/{}", Update: "user/{}/monetary-account/{}/schedule-payment-batch/{}", Delete: "user/{}/monetary-account/{}/schedule-payment-batch/{}"},
+	"ScheduleInstance": {Read: "user/{}/monetary-account/{}/schedule/{}/schedule-instance/{}", List: "user/{}/monetary-account/{}/schedule/{}/schedule-instance", Update: "user/{}/monetary-account/{}/schedule/{}/schedule-instance/{}"},
+	"MasterCardAction": {Read: "user/{}/monetary-account/{}/mastercard-action/{}", List: "user/{}/monetary-account/{}/mastercard-action"},
+	"RequestInquiryBatch": {Create: "user/{}/monetary-account/{}/request-inquiry-batch", Read: "user/{}/monetary-account/{}/request-inquiry-batch/{}", List: "user/{}/monetary-account/{}/request-inquiry-batch", Update: "user/{}/monetary-account/{}/request-inquiry-batch/{}"},
+	"RequestInquiry": {Create: "user/{}/monetary-account/{}/request-inquiry", Read: "user/{}/monetary-account/{}/request-inquiry/{}", List: "user/{}/monetary-account/{}/request-inquiry", Update: "user/{}/monetary-account/{}/request-inquiry/{}"},
+	"RequestResponse": {Read: "user/{}/monetary-account/{}/request-response/{}", List: "user/{}/monetary-account/{}/request-response", Update: "user/{}/monetary-account/{}/request-response/{}"},
+	"TransferwiseTransfer": {Create: "user/{}/transferwise-quote/{}/transferwise-transfer", Read: "user/{}/transferwise-quote/{}/transferwise-transfer/{}", List: "user/{}/transferwise-quote/{}/transferwise-transfer"},
+	"TransferwiseQuote": {Create: "user/{}/transferwise-quote", Read: "user/{}/transferwise-quote/{}"},
+	"ShareInviteMonetaryAccountInquiry": {Create: "user/{}/monetary-account/{}/share-invite-monetary-account-inquiry", Read: "user/{}/monetary-account/{}/share-invite-monetary-account-inquiry/{}", List: "user/{}/monetary-account/{}/share-invite-monetary-account-inquiry", Update: "user/{}/monetary-account/{}/share-invite-monetary-account-inquiry/{}"},
+	"ShareInviteMonetaryAccountResponse": {Read: "user/{}/share-invite-monetary-account-response/{}", List: "user/{}/share-invite-monetary-account-response", Update: "user/{}/share-invite-monetary-account-response/{}"},
+	"SofortMerchantTransaction": {Read: "user/{}/monetary-account/{}/sofort-merchant-transaction/{}", List: "user/{}/monetary-account/{}/sofort-merchant-transaction"},
+	"ExportAnnualOverviewContent": {List: "user/{}/export-annual-overview/{}/content"},
+	"ExportAnnualOverview": {Create: "user/{}/export-annual-overview", Read: "user/{}/export-annual-overview/{}", List: "user/{}/export-annual-overview", Delete: "user/{}/export-annual-overview/{}"},
+	"ExportRibContent": {List: "user/{}/monetary-account/{}/export-rib/{}/content"},
+	"ExportRib": {Create: "user/{}/monetary-account/{}/export-rib", Read: "user/{}/monetary-account/{}/export-rib/{}", List: "user/{}/monetary-account/{}/export-rib", Delete: "user/{}/monetary-account/{}/export-rib/{}"},
+	"ExportStatementCardCsv": {Create: "user/{}/card/{}/export-statement-card-csv", Read: "user/{}/card/{}/export-statement-card-csv/{}", List: "user/{}/card/{}/export-statement-card-csv", Delete: "user/{}/card/{}/export-statement-card-csv/{}"},
+	"ExportStatementCardPdf": {Create: "user/{}/card/{}/export-statement-card-pdf", Read: "user/{}/card/{}/export-statement-card-pdf/{}", List: "user/{}/card/{}/export-statement-card-pdf", Delete: "user/{}/card/{}/export-statement-card-pdf/{}"},
+	"ExportStatementCard": {Read: "user/{}/card/{}/export-statement-card/{}", List: "user/{}/card/{}/export-statement-card"},
+	"ExportStatementCardContent": {List: "user/{}/card/{}/export-statement-card/{}/content"},
+	"ExportStatementContent": {List: "user/{}/monetary-account/{}/customer-statement/{}/content"},
+	"ExportStatementPaymentContent": {List: "user/{}/monetary-account/{}/event/{}/statement/{}/content"},
+	"ExportStatementPayment": {Create: "user/{}/monetary-account/{}/event/{}/statement", Read: "user/{}/monetary-account/{}/event/{}/statement/{}"},
+	"ExportStatement": {Create: "user/{}/monetary-account/{}/customer-statement", Read: "user/{}/monetary-account/{}/customer-statement/{}", List: "user/{}/monetary-account/{}/customer-statement", Delete: "user/{}/monetary-account/{}/customer-statement/{}"},
+	"InsightEvent": {List: "user/{}/insights-search"},
+	"InsightPreferenceDate": {List: "user/{}/insight-preference-date"},
+	"Insight": {List: "user/{}/insights"},
+	"InstallationServerPublicKey": {List: "installation/{}/server-public-key"},
+	"MonetaryAccountBank": {Create: "user/{}/monetary-account-bank", Read: "user/{}/monetary-account-bank/{}", List: "user/{}/monetary-account-bank", Update: "user/{}/monetary-account-bank/{}"},
+	"MonetaryAccountCard": {Read: "user/{}/monetary-account-card/{}", List: "user/{}/monetary-account-card", Update: "user/{}/monetary-account-card/{}"},
+	"MonetaryAccountExternalSavings": {Create: "user/{}/monetary-account-external-savings", Read: "user/{}/monetary-account-external-savings/{}", List: "user/{}/monetary-account-external-savings", Update: "user/{}/monetary-account-external-savings/{}"},
+	"MonetaryAccountExternal": {Create: "user/{}/monetary-account-external", Read: "user/{}/monetary-account-external/{}", List: "user/{}/monetary-account-external", Update: "user/{}/monetary-account-external/{}"},
+	"MonetaryAccountJoint": {Create: "user/{}/monetary-account-joint", Read: "user/{}/monetary-account-joint/{}", List: "user/{}/monetary-account-joint", Update: "user/{}/monetary-account-joint/{}"},
+	"MonetaryAccountSavings": {Create: "user/{}/monetary-account-savings", Read: "user/{}/monetary-account-savings/{}", List: "user/{}/monetary-account-savings", Update: "user/{}/monetary-account-savings/{}"},
+	"MonetaryAccount": {Read: "user/{}/monetary-account/{}", List: "user/{}/monetary-account"},
+	"NoteAttachmentAdyenCardTransaction": {Create: "user/{}/monetary-account/{}/adyen-card-transaction/{}/note-attachment", Read: "user/{}/monetary-account/{}/adyen-card-transaction/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/adyen-card-transaction/{}/note-attachment", Update: "user/{}/monetary-account/{}/adyen-card-transaction/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/adyen-card-transaction/{}/note-attachment/{}"},
+	"NoteTextAdyenCardTransaction": {Create: "user/{}/monetary-account/{}/adyen-card-transaction/{}/note-text", Read: "user/{}/monetary-account/{}/adyen-card-transaction/{}/note-text/{}", List: "user/{}/monetary-account/{}/adyen-card-transaction/{}/note-text", Update: "user/{}/monetary-account/{}/adyen-card-transaction/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/adyen-card-transaction/{}/note-text/{}"},
+	"NoteAttachmentBankSwitchServiceNetherlandsIncomingPayment": {Create: "user/{}/monetary-account/{}/switch-service-payment/{}/note-attachment", Read: "user/{}/monetary-account/{}/switch-service-payment/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/switch-service-payment/{}/note-attachment", Update: "user/{}/monetary-account/{}/switch-service-payment/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/switch-service-payment/{}/note-attachment/{}"},
+	"NoteTextBankSwitchServiceNetherlandsIncomingPayment": {Create: "user/{}/monetary-account/{}/switch-service-payment/{}/note-text", Read: "user/{}/monetary-account/{}/switch-service-payment/{}/note-text/{}", List: "user/{}/monetary-account/{}/switch-service-payment/{}/note-text", Update: "user/{}/monetary-account/{}/switch-service-payment/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/switch-service-payment/{}/note-text/{}"},
+	"NoteAttachmentBunqMeFundraiserResult": {Create: "user/{}/monetary-account/{}/bunqme-fundraiser-result/{}/note-attachment", Read: "user/{}/monetary-account/{}/bunqme-fundraiser-result/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/bunqme-fundraiser-result/{}/note-attachment", Update: "user/{}/monetary-account/{}/bunqme-fundraiser-result/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/bunqme-fundraiser-result/{}/note-attachment/{}"},
+	"NoteTextBunqMeFundraiserResult": {Create: "user/{}/monetary-account/{}/bunqme-fundraiser-result/{}/note-text", Read: "user/{}/monetary-account/{}/bunqme-fundraiser-result/{}/note-text/{}", List: "user/{}/monetary-account/{}/bunqme-fundraiser-result/{}/note-text", Update: "user/{}/monetary-account/{}/bunqme-fundraiser-result/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/bunqme-fundraiser-result/{}/note-text/{}"},
+	"NoteAttachmentDraftPayment": {Create: "user/{}/monetary-account/{}/draft-payment/{}/note-attachment", Read: "user/{}/monetary-account/{}/draft-payment/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/draft-payment/{}/note-attachment", Update: "user/{}/monetary-account/{}/draft-payment/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/draft-payment/{}/note-attachment/{}"},
+	"NoteTextDraftPayment": {Create: "user/{}/monetary-account/{}/draft-payment/{}/note-text", Read: "user/{}/monetary-account/{}/draft-payment/{}/note-text/{}", List: "user/{}/monetary-account/{}/draft-payment/{}/note-text", Update: "user/{}/monetary-account/{}/draft-payment/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/draft-payment/{}/note-text/{}"},
+	"NoteAttachmentIdealMerchantTransaction": {Create: "user/{}/monetary-account/{}/ideal-merchant-transaction/{}/note-attachment", Read: "user/{}/monetary-account/{}/ideal-merchant-transaction/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/ideal-merchant-transaction/{}/note-attachment", Update: "user/{}/monetary-account/{}/ideal-merchant-transaction/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/ideal-merchant-transaction/{}/note-attachment/{}"},
+	"NoteTextIdealMerchantTransaction": {Create: "user/{}/monetary-account/{}/ideal-merchant-transaction/{}/note-text", Read: "user/{}/monetary-account/{}/ideal-merchant-transaction/{}/note-text/{}", List: "user/{}/monetary-account/{}/ideal-merchant-transaction/{}/note-text", Update: "user/{}/monetary-account/{}/ideal-merchant-transaction/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/ideal-merchant-transaction/{}/note-text/{}"},
+	"NoteAttachmentMasterCardAction": {Create: "user/{}/monetary-account/{}/mastercard-action/{}/note-attachment", Read: "user/{}/monetary-account/{}/mastercard-action/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/mastercard-action/{}/note-attachment", Update: "user/{}/monetary-account/{}/mastercard-action/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/mastercard-action/{}/note-attachment/{}"},
+	"NoteTextMasterCardAction": {Create: "user/{}/monetary-account/{}/mastercard-action/{}/note-text", Read: "user/{}/monetary-account/{}/mastercard-action/{}/note-text/{}", List: "user/{}/monetary-account/{}/mastercard-action/{}/note-text", Update: "user/{}/monetary-account/{}/mastercard-action/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/mastercard-action/{}/note-text/{}"},
+	"NoteAttachmentOpenBankingMerchantTransaction": {Create: "user/{}/monetary-account/{}/open-banking-merchant-transaction/{}/note-attachment", Read: "user/{}/monetary-account/{}/open-banking-merchant-transaction/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/open-banking-merchant-transaction/{}/note-attachment", Update: "user/{}/monetary-account/{}/open-banking-merchant-transaction/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/open-banking-merchant-transaction/{}/note-attachment/{}"},
+	"NoteTextOpenBankingMerchantTransaction": {Create: "user/{}/monetary-account/{}/open-banking-merchant-transaction/{}/note-text", Read: "user/{}/monetary-account/{}/open-banking-merchant-transaction/{}/note-text/{}", List: "user/{}/monetary-account/{}/open-banking-merchant-transaction/{}/note-text", Update: "user/{}/monetary-account/{}/open-banking-merchant-transaction/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/open-banking-merchant-transaction/{}/note-text/{}"},
+	"NoteAttachmentPaymentBatch": {Create: "user/{}/monetary-account/{}/payment-batch/{}/note-attachment", Read: "user/{}/monetary-account/{}/payment-batch/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/payment-batch/{}/note-attachment", Update: "user/{}/monetary-account/{}/payment-batch/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/payment-batch/{}/note-attachment/{}"},
+	"NoteTextPaymentBatch": {Create: "user/{}/monetary-account/{}/payment-batch/{}/note-text", Read: "user/{}/monetary-account/{}/payment-batch/{}/note-text/{}", List: "user/{}/monetary-account/{}/payment-batch/{}/note-text", Update: "user/{}/monetary-account/{}/payment-batch/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/payment-batch/{}/note-text/{}"},
+	"NoteAttachmentPaymentDelayed": {Create: "user/{}/monetary-account/{}/payment-delayed/{}/note-attachment", Read: "user/{}/monetary-account/{}/payment-delayed/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/payment-delayed/{}/note-attachment", Update: "user/{}/monetary-account/{}/payment-delayed/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/payment-delayed/{}/note-attachment/{}"},
+	"NoteTextPaymentDelayed": {Create: "user/{}/monetary-account/{}/payment-delayed/{}/note-text", Read: "user/{}/monetary-account/{}/payment-delayed/{}/note-text/{}", List: "user/{}/monetary-account/{}/payment-delayed/{}/note-text", Update: "user/{}/monetary-account/{}/payment-delayed/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/payment-delayed/{}/note-text/{}"},
+	"NoteAttachmentPayment": {Create: "user/{}/monetary-account/{}/payment/{}/note-attachment", Read: "user/{}/monetary-account/{}/payment/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/payment/{}/note-attachment", Update: "user/{}/monetary-account/{}/payment/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/payment/{}/note-attachment/{}"},
+	"NoteTextPayment": {Create: "user/{}/monetary-account/{}/payment/{}/note-text", Read: "user/{}/monetary-account/{}/payment/{}/note-text/{}", List: "user/{}/monetary-account/{}/payment/{}/note-text", Update: "user/{}/monetary-account/{}/payment/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/payment/{}/note-text/{}"},
+	"NoteAttachmentRequestInquiryBatch": {Create: "user/{}/monetary-account/{}/request-inquiry-batch/{}/note-attachment", Read: "user/{}/monetary-account/{}/request-inquiry-batch/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/request-inquiry-batch/{}/note-attachment", Update: "user/{}/monetary-account/{}/request-inquiry-batch/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/request-inquiry-batch/{}/note-attachment/{}"},
+	"NoteTextRequestInquiryBatch": {Create: "user/{}/monetary-account/{}/request-inquiry-batch/{}/note-text", Read: "user/{}/monetary-account/{}/request-inquiry-batch/{}/note-text/{}", List: "user/{}/monetary-account/{}/request-inquiry-batch/{}/note-text", Update: "user/{}/monetary-account/{}/request-inquiry-batch/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/request-inquiry-batch/{}/note-text/{}"},
+	"NoteAttachmentRequestInquiry": {Create: "user/{}/monetary-account/{}/request-inquiry/{}/note-attachment", Read: "user/{}/monetary-account/{}/request-inquiry/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/request-inquiry/{}/note-attachment", Update: "user/{}/monetary-account/{}/request-inquiry/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/request-inquiry/{}/note-attachment/{}"},
+	"NoteTextRequestInquiry": {Create: "user/{}/monetary-account/{}/request-inquiry/{}/note-text", Read: "user/{}/monetary-account/{}/request-inquiry/{}/note-text/{}", List: "user/{}/monetary-account/{}/request-inquiry/{}/note-text", Update: "user/{}/monetary-account/{}/request-inquiry/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/request-inquiry/{}/note-text/{}"},
+	"NoteAttachmentRequestResponse": {Create: "user/{}/monetary-account/{}/request-response/{}/note-attachment", Read: "user/{}/monetary-account/{}/request-response/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/request-response/{}/note-attachment", Update: "user/{}/monetary-account/{}/request-response/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/request-response/{}/note-attachment/{}"},
+	"NoteTextRequestResponse": {Create: "user/{}/monetary-account/{}/request-response/{}/note-text", Read: "user/{}/monetary-account/{}/request-response/{}/note-text/{}", List: "user/{}/monetary-account/{}/request-response/{}/note-text", Update: "user/{}/monetary-account/{}/request-response/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/request-response/{}/note-text/{}"},
+	"NoteAttachmentScheduleInstance": {Create: "user/{}/monetary-account/{}/schedule/{}/schedule-instance/{}/note-attachment", Read: "user/{}/monetary-account/{}/schedule/{}/schedule-instance/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/schedule/{}/schedule-instance/{}/note-attachment", Update: "user/{}/monetary-account/{}/schedule/{}/schedule-instance/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/schedule/{}/schedule-instance/{}/note-attachment/{}"},
+	"NoteTextScheduleInstance": {Create: "user/{}/monetary-account/{}/schedule/{}/schedule-instance/{}/note-text", Read: "user/{}/monetary-account/{}/schedule/{}/schedule-instance/{}/note-text/{}", List: "user/{}/monetary-account/{}/schedule/{}/schedule-instance/{}/note-text", Update: "user/{}/monetary-account/{}/schedule/{}/schedule-instance/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/schedule/{}/schedule-instance/{}/note-text/{}"},
+	"NoteAttachmentSchedulePaymentBatch": {Create: "user/{}/monetary-account/{}/schedule-payment-batch/{}/note-attachment", Read: "user/{}/monetary-account/{}/schedule-payment-batch/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/schedule-payment-batch/{}/note-attachment", Update: "user/{}/monetary-account/{}/schedule-payment-batch/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/schedule-payment-batch/{}/note-attachment/{}"},
+	"NoteTextSchedulePaymentBatch": {Create: "user/{}/monetary-account/{}/schedule-payment-batch/{}/note-text", Read: "user/{}/monetary-account/{}/schedule-payment-batch/{}/note-text/{}", List: "user/{}/monetary-account/{}/schedule-payment-batch/{}/note-text", Update: "user/{}/monetary-account/{}/schedule-payment-batch/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/schedule-payment-batch/{}/note-text/{}"},
+	"NoteAttachmentSchedulePayment": {Create: "user/{}/monetary-account/{}/schedule-payment/{}/note-attachment", Read: "user/{}/monetary-account/{}/schedule-payment/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/schedule-payment/{}/note-attachment", Update: "user/{}/monetary-account/{}/schedule-payment/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/schedule-payment/{}/note-attachment/{}"},
+	"NoteTextSchedulePayment": {Create: "user/{}/monetary-account/{}/schedule-payment/{}/note-text", Read: "user/{}/monetary-account/{}/schedule-payment/{}/note-text/{}", List: "user/{}/monetary-account/{}/schedule-payment/{}/note-text", Update: "user/{}/monetary-account/{}/schedule-payment/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/schedule-payment/{}/note-text/{}"},
+	"NoteAttachmentScheduleRequestBatch": {Create: "user/{}/monetary-account/{}/schedule-request-inquiry-batch/{}/note-attachment", Read: "user/{}/monetary-account/{}/schedule-request-inquiry-batch/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/schedule-request-inquiry-batch/{}/note-attachment", Update: "user/{}/monetary-account/{}/schedule-request-inquiry-batch/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/schedule-request-inquiry-batch/{}/note-attachment/{}"},
+	"NoteTextScheduleRequestBatch": {Create: "user/{}/monetary-account/{}/schedule-request-inquiry-batch/{}/note-text", Read: "user/{}/monetary-account/{}/schedule-request-inquiry-batch/{}/note-text/{}", List: "user/{}/monetary-account/{}/schedule-request-inquiry-batch/{}/note-text", Update: "user/{}/monetary-account/{}/schedule-request-inquiry-batch/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/schedule-request-inquiry-batch/{}/note-text/{}"},
+	"NoteAttachmentScheduleRequest": {Create: "user/{}/monetary-account/{}/schedule-request-inquiry/{}/note-attachment", Read: "user/{}/monetary-account/{}/schedule-request-inquiry/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/schedule-request-inquiry/{}/note-attachment", Update: "user/{}/monetary-account/{}/schedule-request-inquiry/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/schedule-request-inquiry/{}/note-attachment/{}"},
+	"NoteTextScheduleRequest": {Create: "user/{}/monetary-account/{}/schedule-request-inquiry/{}/note-text", Read: "user/{}/monetary-account/{}/schedule-request-inquiry/{}/note-text/{}", List: "user/{}/monetary-account/{}/schedule-request-inquiry/{}/note-text", Update: "user/{}/monetary-account/{}/schedule-request-inquiry/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/schedule-request-inquiry/{}/note-text/{}"},
+	"NoteAttachmentSofortMerchantTransaction": {Create: "user/{}/monetary-account/{}/sofort-merchant-transaction/{}/note-attachment", Read: "user/{}/monetary-account/{}/sofort-merchant-transaction/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/sofort-merchant-transaction/{}/note-attachment", Update: "user/{}/monetary-account/{}/sofort-merchant-transaction/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/sofort-merchant-transaction/{}/note-attachment/{}"},
+	"NoteTextSofortMerchantTransaction": {Create: "user/{}/monetary-account/{}/sofort-merchant-transaction/{}/note-text", Read: "user/{}/monetary-account/{}/sofort-merchant-transaction/{}/note-text/{}", List: "user/{}/monetary-account/{}/sofort-merchant-transaction/{}/note-text", Update: "user/{}/monetary-account/{}/sofort-merchant-transaction/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/sofort-merchant-transaction/{}/note-text/{}"},
+	"NoteAttachmentWhitelistResult": {Create: "user/{}/monetary-account/{}/whitelist/{}/whitelist-result/{}/note-attachment", Read: "user/{}/monetary-account/{}/whitelist/{}/whitelist-result/{}/note-attachment/{}", List: "user/{}/monetary-account/{}/whitelist/{}/whitelist-result/{}/note-attachment", Update: "user/{}/monetary-account/{}/whitelist/{}/whitelist-result/{}/note-attachment/{}", Delete: "user/{}/monetary-account/{}/whitelist/{}/whitelist-result/{}/note-attachment/{}"},
+	"NoteTextWhitelistResult": {Create: "user/{}/monetary-account/{}/whitelist/{}/whitelist-result/{}/note-text", Read: "user/{}/monetary-account/{}/whitelist/{}/whitelist-result/{}/note-text/{}", List: "user/{}/monetary-account/{}/whitelist/{}/whitelist-result/{}/note-text", Update: "user/{}/monetary-account/{}/whitelist/{}/whitelist-result/{}/note-text/{}", Delete: "user/{}/monetary-account/{}/whitelist/{}/whitelist-result/{}/note-text/{}"},
+	"NotificationFilterEmail": {Create: "user/{}/notification-filter-email", List: "user/{}/notification-filter-email"},
+	"NotificationFilterFailure": {Create: "user/{}/notification-filter-failure", List: "user/{}/notification-filter-failure"},
+	"NotificationFilterPush": {Create: "user/{}/notification-filter-push", List: "user/{}/notification-filter-push"},
+	"NotificationFilterUrl": {Create: "user/{}/notification-filter-url", List: "user/{}/notification-filter-url"},
+	"NotificationFilterUrlMonetaryAccount": {Create: "user/{}/monetary-account/{}/notification-filter-url", List: "user/{}/monetary-account/{}/notification-filter-url"},
+	"User": {Read: "user/{}", List: "user"},
+	"UserPerson": {Read: "user-person/{}", Update: "user-person/{}"},
+	"UserPaymentServiceProvider": {Read: "user-payment-service-provider/{}"},
+	"OauthCallbackUrl": {Create: "user/{}/oauth-client/{}/callback-url", Read: "user/{}/oauth-client/{}/callback-url/{}", List: "user/{}/oauth-client/{}/callback-url", Update: "user/{}/oauth-client/{}/callback-url/{}", Delete: "user/{}/oauth-client/{}/callback-url/{}"},
+	"OauthClient": {Create: "user/{}/oauth-client", Read: "user/{}/oauth-client/{}", List: "user/{}/oauth-client", Update: "user/{}/oauth-client/{}"},
+	"PaymentAutoAllocateDefinition": {List: "user/{}/monetary-account/{}/payment-auto-allocate/{}/definition"},
+	"PaymentAutoAllocate": {Create: "user/{}/monetary-account/{}/payment-auto-allocate", Read: "user/{}/monetary-account/{}/payment-auto-allocate/{}", List: "user/{}/monetary-account/{}/payment-auto-allocate", Update: "user/{}/monetary-account/{}/payment-auto-allocate/{}", Delete: "user/{}/monetary-account/{}/payment-auto-allocate/{}"},
+	"PaymentAutoAllocateUser": {List: "user/{}/payment-auto-allocate"},
+	"PaymentServiceProviderCredential": {Create: "payment-service-provider-credential", Read: "payment-service-provider-credential/{}"},
+	"PaymentServiceProviderDraftPayment": {Create: "user/{}/payment-service-provider-draft-payment", Read: "user/{}/payment-service-provider-draft-payment/{}", List: "user/{}/payment-service-provider-draft-payment", Update: "user/{}/payment-service-provider-draft-payment/{}"},
+	"PaymentServiceProviderIssuerTransaction": {Create: "user/{}/payment-service-provider-issuer-transaction", Read: "user/{}/payment-service-provider-issuer-transaction/{}", List: "user/{}/payment-service-provider-issuer-transaction", Update: "user/{}/payment-service-provider-issuer-transaction/{}"},
+	"PermittedIp": {Create: "user/{}/credential-password-ip/{}/ip", Read: "user/{}/credential-password-ip/{}/ip/{}", List: "user/{}/credential-password-ip/{}/ip", Update: "user/{}/credential-password-ip/{}/ip/{}"},
+	"SandboxUserCompany": {Create: "sandbox-user-company"},
+	"SandboxUserPerson": {Create: "sandbox-user-person"},
+	"ScheduleUser": {List: "user/{}/schedule"},
+	"Session": {Delete: "session/{}"},
+	"TokenQrRequestIdeal": {Create: "user/{}/token-qr-request-ideal"},
+	"TokenQrRequestSofort": {Create: "user/{}/token-qr-request-sofort"},
+	"TransferwiseAccountQuote": {Create: "user/{}/transferwise-quote/{}/transferwise-recipient", Read: "user/{}/transferwise-quote/{}/transferwise-recipient/{}", List: "user/{}/transferwise-quote/{}/transferwise-recipient", Delete: "user/{}/transferwise-quote/{}/transferwise-recipient/{}"},
+	"TransferwiseAccountRequirement": {Create: "user/{}/transferwise-quote/{}/transferwise-recipient-requirement", List: "user/{}/transferwise-quote/{}/transferwise-recipient-requirement"},
+	"TransferwiseCurrency": {List: "user/{}/transferwise-currency"},
+	"TransferwiseQuoteTemporary": {Create: "user/{}/transferwise-quote-temporary", Read: "user/{}/transferwise-quote-temporary/{}"},
+	"TransferwiseTransferRequirement": {Create: "user/{}/transferwise-quote/{}/transferwise-transfer-requirement"},
+	"TransferwiseUser": {Create: "user/{}/transferwise-user", List: "user/{}/transferwise-user"},
+	"TreeProgress": {List: "user/{}/tree-progress"},
+	"UserCompanyName": {List: "user-company/{}/name"},
+	"UserCredentialPasswordIp": {Read: "user/{}/credential-password-ip/{}", List: "user/{}/credential-password-ip"},
+	"UserLegalName": {List: "user/{}/legal-name"},
+	"WhitelistSddOneOff": {Create: "user/{}/whitelist-sdd-one-off", Read: "user/{}/whitelist-sdd-one-off/{}", List: "user/{}/whitelist-sdd-one-off", Update: "user/{}/whitelist-sdd-one-off/{}", Delete: "user/{}/whitelist-sdd-one-off/{}"},
+	"WhitelistSddRecurring": {Create: "user/{}/whitelist-sdd-recurring", Read: "user/{}/whitelist-sdd-recurring/{}", List: "user/{}/whitelist-sdd-recurring", Update: "user/{}/whitelist-sdd-recurring/{}", Delete: "user/{}/whitelist-sdd-recurring/{}"},
+	"WhitelistSdd": {Read: "user/{}/whitelist-sdd/{}", List: "user/{}/whitelist-sdd"},
+	"WhitelistSddMonetaryAccountPaying": {Read: "user/{}/monetary-account/{}/whitelist-sdd/{}", List: "user/{}/monetary-account/{}/whitelist-sdd"},
+	"MasterCardPayment": {List: "user/{}/monetary-account/{}/mastercard-action/{}/payment"},
+	"MasterCardIdentityCheckChallengeRequestUser": {Read: "user/{}/challenge-request/{}", Update: "user/{}/challenge-request/{}"},
+	"HealthCheck": {List: "health-check"},
+}
+
+var listRegistry = map[string]listFunc{
+	"BillingContractSubscription": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.BillingContractSubscription.List(ctx, opts))
+	},
+	"CustomerLimit": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.CustomerLimit.List(ctx, opts))
+	},
+	"Invoice": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.Invoice.List(ctx, monetaryAccountID, opts))
+	},
+	"InvoiceByUser": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.InvoiceByUser.List(ctx, opts))
+	},
+	"AdditionalTransactionInformationCategory": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.AdditionalTransactionInformationCategory.List(ctx, opts))
+	},
+	"Payment": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.Payment.List(ctx, monetaryAccountID, opts))
+	},
+	"PaymentBatch": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.PaymentBatch.List(ctx, monetaryAccountID, opts))
+	},
+	"BunqMeFundraiserProfileUser": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.BunqMeFundraiserProfileUser.List(ctx, opts))
+	},
+	"BunqMeTab": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.BunqMeTab.List(ctx, monetaryAccountID, opts))
+	},
+	"CardName": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.CardName.List(ctx, opts))
+	},
+	"Card": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.Card.List(ctx, opts))
+	},
+	"CertificatePinned": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.CertificatePinned.List(ctx, opts))
+	},
+	"Company": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.Company.List(ctx, opts))
+	},
+	"CurrencyCloudBeneficiaryRequirement": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.CurrencyCloudBeneficiaryRequirement.List(ctx, opts))
+	},
+	"CurrencyCloudBeneficiary": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.CurrencyCloudBeneficiary.List(ctx, opts))
+	},
+	"CurrencyConversion": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.CurrencyConversion.List(ctx, monetaryAccountID, opts))
+	},
+	"DeviceServer": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.DeviceServer.List(ctx, opts))
+	},
+	"Device": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.Device.List(ctx, opts))
+	},
+	"DraftPayment": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.DraftPayment.List(ctx, monetaryAccountID, opts))
+	},
+	"Schedule": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.Schedule.List(ctx, monetaryAccountID, opts))
+	},
+	"Event": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.Event.List(ctx, opts))
+	},
+	"IdealMerchantTransaction": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.IdealMerchantTransaction.List(ctx, monetaryAccountID, opts))
+	},
+	"SchedulePayment": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.SchedulePayment.List(ctx, monetaryAccountID, opts))
+	},
+	"MasterCardAction": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.MasterCardAction.List(ctx, monetaryAccountID, opts))
+	},
+	"RequestInquiryBatch": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.RequestInquiryBatch.List(ctx, monetaryAccountID, opts))
+	},
+	"RequestInquiry": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.RequestInquiry.List(ctx, monetaryAccountID, opts))
+	},
+	"RequestResponse": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.RequestResponse.List(ctx, monetaryAccountID, opts))
+	},
+	"ShareInviteMonetaryAccountInquiry": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.ShareInviteMonetaryAccountInquiry.List(ctx, monetaryAccountID, opts))
+	},
+	"ShareInviteMonetaryAccountResponse": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.ShareInviteMonetaryAccountResponse.List(ctx, opts))
+	},
+	"SofortMerchantTransaction": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.SofortMerchantTransaction.List(ctx, monetaryAccountID, opts))
+	},
+	"ExportAnnualOverview": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.ExportAnnualOverview.List(ctx, opts))
+	},
+	"ExportRib": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.ExportRib.List(ctx, monetaryAccountID, opts))
+	},
+	"ExportStatement": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.ExportStatement.List(ctx, monetaryAccountID, opts))
+	},
+	"InsightEvent": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.InsightEvent.List(ctx, opts))
+	},
+	"InsightPreferenceDate": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.InsightPreferenceDate.List(ctx, opts))
+	},
+	"Insight": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.Insight.List(ctx, opts))
+	},
+	"MonetaryAccountBank": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.MonetaryAccountBank.List(ctx, opts))
+	},
+	"MonetaryAccountCard": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.MonetaryAccountCard.List(ctx, opts))
+	},
+	"MonetaryAccountExternalSavings": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.MonetaryAccountExternalSavings.List(ctx, opts))
+	},
+	"MonetaryAccountExternal": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.MonetaryAccountExternal.List(ctx, opts))
+	},
+	"MonetaryAccountJoint": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.MonetaryAccountJoint.List(ctx, opts))
+	},
+	"MonetaryAccountSavings": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.MonetaryAccountSavings.List(ctx, opts))
+	},
+	"MonetaryAccount": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.MonetaryAccount.List(ctx, opts))
+	},
+	"NotificationFilterEmail": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.NotificationFilterEmail.List(ctx, opts))
+	},
+	"NotificationFilterFailure": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.NotificationFilterFailure.List(ctx, opts))
+	},
+	"NotificationFilterPush": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.NotificationFilterPush.List(ctx, opts))
+	},
+	"NotificationFilterUrl": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.NotificationFilterUrl.List(ctx, opts))
+	},
+	"NotificationFilterUrlMonetaryAccount": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.NotificationFilterUrlMonetaryAccount.List(ctx, monetaryAccountID, opts))
+	},
+	"User": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.User.List(ctx, opts))
+	},
+	"OauthClient": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.OauthClient.List(ctx, opts))
+	},
+	"PaymentAutoAllocate": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.PaymentAutoAllocate.List(ctx, monetaryAccountID, opts))
+	},
+	"PaymentAutoAllocateUser": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.PaymentAutoAllocateUser.List(ctx, opts))
+	},
+	"PaymentServiceProviderDraftPayment": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.PaymentServiceProviderDraftPayment.List(ctx, opts))
+	},
+	"PaymentServiceProviderIssuerTransaction": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.PaymentServiceProviderIssuerTransaction.List(ctx, opts))
+	},
+	"ScheduleUser": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.ScheduleUser.List(ctx, opts))
+	},
+	"TransferwiseCurrency": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.TransferwiseCurrency.List(ctx, opts))
+	},
+	"TransferwiseUser": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.TransferwiseUser.List(ctx, opts))
+	},
+	"TreeProgress": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.TreeProgress.List(ctx, opts))
+	},
+	"UserCredentialPasswordIp": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.UserCredentialPasswordIp.List(ctx, opts))
+	},
+	"UserLegalName": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.UserLegalName.List(ctx, opts))
+	},
+	"WhitelistSddOneOff": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.WhitelistSddOneOff.List(ctx, opts))
+	},
+	"WhitelistSddRecurring": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.WhitelistSddRecurring.List(ctx, opts))
+	},
+	"WhitelistSdd": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.WhitelistSdd.List(ctx, opts))
+	},
+	"WhitelistSddMonetaryAccountPaying": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.WhitelistSddMonetaryAccountPaying.List(ctx, monetaryAccountID, opts))
+	},
+	"HealthCheck": func(c *Client, ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[any, error] {
+		return anySeq(c.HealthCheck.List(ctx, opts))
+	},
+}
+
+func (o *AttachmentMonetaryAccountPayment) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on AttachmentMonetaryAccountPayment")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *CardPinAssignment) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on CardPinAssignment")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *CardPrimaryAccountNumber) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on CardPrimaryAccountNumber")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *MasterCardActionReference) FetchEvent(ctx context.Context, c *Client) (*Event, error) {
+	if o.EventID == 0 {
+		return nil, fmt.Errorf("no event_id set on MasterCardActionReference")
+	}
+	return c.Event.Get(ctx, o.EventID)
+}
+
+func (o *Payment) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on Payment")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *GinmonTransaction) FetchEvent(ctx context.Context, c *Client) (*Event, error) {
+	if o.EventID == 0 {
+		return nil, fmt.Errorf("no event_id set on GinmonTransaction")
+	}
+	return c.Event.Get(ctx, o.EventID)
+}
+
+func (o *PaymentSuspendedOutgoing) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on PaymentSuspendedOutgoing")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *BunqMeFundraiserProfileUser) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on BunqMeFundraiserProfileUser")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *BunqMeTab) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on BunqMeTab")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *CardReplacement) FetchCard(ctx context.Context, c *Client) (*Card, error) {
+	if o.CardID == 0 {
+		return nil, fmt.Errorf("no card_id set on CardReplacement")
+	}
+	return c.Card.Get(ctx, o.CardID)
+}
+
+func (o *CompanyEmployeeCardLimit) FetchUserCompany(ctx context.Context, c *Client) (*UserCompany, error) {
+	if o.UserCompanyID == 0 {
+		return nil, fmt.Errorf("no user_company_id set on CompanyEmployeeCardLimit")
+	}
+	return c.UserCompany.Get(ctx, o.UserCompanyID)
+}
+
+func (o *DraftPayment) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on DraftPayment")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *Event) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on Event")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *IdealMerchantTransaction) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on IdealMerchantTransaction")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *MasterCardAction) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on MasterCardAction")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *MasterCardAction) FetchCard(ctx context.Context, c *Client) (*Card, error) {
+	if o.CardID == 0 {
+		return nil, fmt.Errorf("no card_id set on MasterCardAction")
+	}
+	return c.Card.Get(ctx, o.CardID)
+}
+
+func (o *RequestInquiry) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on RequestInquiry")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *RequestResponse) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on RequestResponse")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *RequestResponse) FetchEvent(ctx context.Context, c *Client) (*Event, error) {
+	if o.EventID == 0 {
+		return nil, fmt.Errorf("no event_id set on RequestResponse")
+	}
+	return c.Event.Get(ctx, o.EventID)
+}
+
+func (o *ShareInviteMonetaryAccountInquiry) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on ShareInviteMonetaryAccountInquiry")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *ShareInviteMonetaryAccountResponse) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on ShareInviteMonetaryAccountResponse")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *SofortMerchantTransaction) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on SofortMerchantTransaction")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *ExportStatementCardCsv) FetchCard(ctx context.Context, c *Client) (*Card, error) {
+	if o.CardID == 0 {
+		return nil, fmt.Errorf("no card_id set on ExportStatementCardCsv")
+	}
+	return c.Card.Get(ctx, o.CardID)
+}
+
+func (o *ExportStatementCardPdf) FetchCard(ctx context.Context, c *Client) (*Card, error) {
+	if o.CardID == 0 {
+		return nil, fmt.Errorf("no card_id set on ExportStatementCardPdf")
+	}
+	return c.Card.Get(ctx, o.CardID)
+}
+
+func (o *ExportStatementCard) FetchCard(ctx context.Context, c *Client) (*Card, error) {
+	if o.CardID == 0 {
+		return nil, fmt.Errorf("no card_id set on ExportStatementCard")
+	}
+	return c.Card.Get(ctx, o.CardID)
+}
+
+func (o *InsightEvent) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on InsightEvent")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *CoOwnerInviteResponse) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on CoOwnerInviteResponse")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *TokenQrRequestIdeal) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on TokenQrRequestIdeal")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *MasterCardPayment) FetchMonetaryAccount(ctx context.Context, c *Client) (*MonetaryAccount, error) {
+	if o.MonetaryAccountID == 0 {
+		return nil, fmt.Errorf("no monetary_account_id set on MasterCardPayment")
+	}
+	return c.MonetaryAccount.Get(ctx, o.MonetaryAccountID)
+}
+
+func (o *MasterCardIdentityCheckChallengeRequestUser) FetchEvent(ctx context.Context, c *Client) (*Event, error) {
+	if o.EventID == 0 {
+		return nil, fmt.Errorf("no event_id set on MasterCardIdentityCheckChallengeRequestUser")
+	}
+	return c.Event.Get(ctx, o.EventID)
+}
+
+func (o *MasterCardIdentityCheckChallengeRequestUser) FetchCard(ctx context.Context, c *Client) (*Card, error) {
+	if o.CardID == 0 {
+		return nil, fmt.Errorf("no card_id set on MasterCardIdentityCheckChallengeRequestUser")
+	}
+	return c.Card.Get(ctx, o.CardID)
+}
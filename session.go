@@ -2,9 +2,15 @@ package bunq
 
 import (
 	"context"
+	"crypto/rsa"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,19 +19,10 @@ import (
 // NewClient creates a new bunq API client. It performs the full bootstrap:
 // installation → device-server → session-server → find primary account.
 func NewClient(ctx context.Context, cfg Config) (*Client, error) {
-	if cfg.Description == "" {
-		cfg.Description = "bunq-go"
-	}
-	httpClient := cfg.HTTPClient
-	if httpClient == nil {
-		httpClient = http.DefaultClient
-	}
-
-	c := &Client{
-		cfg:        cfg,
-		httpClient: httpClient,
-		baseURL:    cfg.Environment.BaseURL,
+	if err := checkEnvironment(cfg); err != nil {
+		return nil, err
 	}
+	c := newClient(cfg)
 
 	// 1. Generate RSA key pair
 	privateKey, err := generateRSAKeyPair()
@@ -35,61 +32,159 @@ func NewClient(ctx context.Context, cfg Config) (*Client, error) {
 	c.privateKey = privateKey
 
 	// 2. POST /installation
-	if err := c.doInstallation(ctx); err != nil {
-		return nil, fmt.Errorf("installation: %w", err)
+	if c.bootstrap.InstallationTime, err = c.bootstrapStep(BootstrapStepInstallation, func() (err error) {
+		c.bootstrap.InstallationID, err = c.doInstallation(ctx)
+		return err
+	}); err != nil {
+		return nil, c.bootstrapError(BootstrapStepInstallation, fmt.Errorf("installation: %w", err))
 	}
 
 	// 3. POST /device-server
-	if err := c.doDeviceServer(ctx); err != nil {
-		return nil, fmt.Errorf("device-server: %w", err)
+	if c.bootstrap.DeviceTime, err = c.bootstrapStep(BootstrapStepDeviceServer, func() (err error) {
+		c.bootstrap.DeviceID, err = c.doDeviceServer(ctx)
+		return err
+	}); err != nil {
+		return nil, c.bootstrapError(BootstrapStepDeviceServer, fmt.Errorf("device-server: %w", err))
 	}
 
 	// 4. POST /session-server
-	if err := c.doSessionServer(ctx); err != nil {
-		return nil, fmt.Errorf("session-server: %w", err)
+	if c.bootstrap.SessionTime, err = c.bootstrapStep(BootstrapStepSessionServer, func() (err error) {
+		c.bootstrap.SessionID, c.bootstrap.UserType, err = c.doSessionServer(ctx)
+		return err
+	}); err != nil {
+		return nil, c.bootstrapError(BootstrapStepSessionServer, fmt.Errorf("session-server: %w", err))
 	}
 
 	// 5. Find primary monetary account
-	if err := c.findPrimaryAccount(ctx); err != nil {
-		return nil, fmt.Errorf("finding primary account: %w", err)
+	if c.bootstrap.AccountTime, err = c.bootstrapStep(BootstrapStepPrimaryAccount, func() error {
+		return c.findPrimaryAccount(ctx)
+	}); err != nil {
+		return nil, c.bootstrapError(BootstrapStepPrimaryAccount, fmt.Errorf("finding primary account: %w", err))
 	}
+	c.bootstrap.UserID = c.userID
+	c.bootstrap.PrimaryMonetaryAccountID = c.primaryMonetaryAccountID
 
 	// 6. Wire up services
 	c.initServices()
+	c.initCustomServices()
 
 	return c, nil
 }
 
-func (c *Client) doInstallation(ctx context.Context) error {
-	reqBody := map[string]string{
-		"client_public_key": publicKeyToPEM(&c.privateKey.PublicKey),
+// newClient applies Config defaults and returns a Client that has not been
+// bootstrapped yet.
+func newClient(cfg Config) *Client {
+	if cfg.Description == "" {
+		cfg.Description = "bunq-go"
+	}
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
 	}
 
+	return &Client{
+		cfg:        cfg,
+		httpClient: httpClient,
+		baseURL:    cfg.Environment.BaseURL,
+	}
+}
+
+// bootstrapError records what the bootstrap registered before step failed.
+func (c *Client) bootstrapError(step string, err error) error {
+	return &BootstrapError{
+		Step:           step,
+		InstallationID: c.bootstrap.InstallationID,
+		DeviceID:       c.bootstrap.DeviceID,
+		SessionID:      c.bootstrap.SessionID,
+		Err:            err,
+
+		// The steps after device-server only run once it succeeded.
+		DeviceAttempted: c.bootstrap.DeviceID != 0 || step == BootstrapStepDeviceServer,
+	}
+}
+
+// BootstrapResult describes how a client was set up, to help diagnose
+// bootstrap problems such as an unexpected primary account.
+type BootstrapResult struct {
+	InstallationID           int
+	DeviceID                 int
+	SessionID                int
+	UserID                   int
+	UserType                 string // e.g. "UserPerson" or "UserCompany"
+	PrimaryMonetaryAccountID int
+
+	// Time spent in each bootstrap step.
+	InstallationTime time.Duration
+	DeviceTime       time.Duration
+	SessionTime      time.Duration
+	AccountTime      time.Duration
+}
+
+// Bootstrap returns the result of the client's bootstrap. For clients created
+// with NewClientFromPythonContext, the installation and device fields are zero,
+// as are the session fields unless a new session had to be opened.
+func (c *Client) Bootstrap() BootstrapResult {
+	return c.bootstrap
+}
+
+// The bootstrap request bodies are structs rather than maps so that the
+// signed bytes only change when a field is added or reordered here. Fields
+// are in the order the maps they replaced used to marshal to.
+type installationRequest struct {
+	ClientPublicKey string `json:"client_public_key"`
+}
+
+type deviceServerRequest struct {
+	Description  string   `json:"description"`
+	PermittedIPs []string `json:"permitted_ips"`
+	Secret       string   `json:"secret"`
+}
+
+type sessionServerRequest struct {
+	Secret string `json:"secret"`
+}
+
+// doInstallation registers the client's public key and returns the
+// installation ID.
+func (c *Client) doInstallation(ctx context.Context) (int, error) {
+	reqBody := installationRequest{ClientPublicKey: publicKeyToPEM(&c.privateKey.PublicKey)}
+
 	body, _, err := c.request(ctx, http.MethodPost, "installation", reqBody, false)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	// Response: {"Response":[{"Id":{"id":N}},{"Token":{"token":"..."}},{"ServerPublicKey":{"server_public_key":"..."}}]}
 	var envelope struct {
-		Response []json.RawMessage `json:"Response"`
+		Response responseItems `json:"Response"`
 	}
 	if err := json.Unmarshal(body, &envelope); err != nil {
-		return fmt.Errorf("parsing installation response: %w", err)
+		return 0, fmt.Errorf("parsing installation response: %w", err)
 	}
 
+	var installationID int
 	for _, raw := range envelope.Response {
 		var item map[string]json.RawMessage
 		if err := json.Unmarshal(raw, &item); err != nil {
 			continue
 		}
 
+		if idJSON, ok := item["Id"]; ok {
+			var id struct {
+				ID int `json:"id"`
+			}
+			if err := json.Unmarshal(idJSON, &id); err != nil {
+				return 0, fmt.Errorf("parsing installation ID: %w", err)
+			}
+			installationID = id.ID
+		}
+
 		if tokenJSON, ok := item["Token"]; ok {
 			var token struct {
 				Token string `json:"token"`
 			}
 			if err := json.Unmarshal(tokenJSON, &token); err != nil {
-				return fmt.Errorf("parsing token: %w", err)
+				return 0, fmt.Errorf("parsing token: %w", err)
 			}
 			c.installationToken = token.Token
 		}
@@ -99,65 +194,133 @@ func (c *Client) doInstallation(ctx context.Context) error {
 				ServerPublicKey string `json:"server_public_key"`
 			}
 			if err := json.Unmarshal(keyJSON, &key); err != nil {
-				return fmt.Errorf("parsing server public key: %w", err)
+				return 0, fmt.Errorf("parsing server public key: %w", err)
 			}
 			pub, err := parsePublicKeyPEM(key.ServerPublicKey)
 			if err != nil {
-				return fmt.Errorf("parsing server public key PEM: %w", err)
+				return 0, &InstallationError{Reason: InstallationBadServerKey, Err: err}
 			}
 			c.serverPublicKey = pub
 		}
 	}
 
 	if c.installationToken == "" {
-		return fmt.Errorf("no installation token in response")
+		return 0, &InstallationError{Reason: InstallationNoToken}
 	}
 	if c.serverPublicKey == nil {
-		return fmt.Errorf("no server public key in response")
+		return 0, &InstallationError{Reason: InstallationNoServerKey}
 	}
 
-	return nil
+	return installationID, nil
 }
 
-func (c *Client) doDeviceServer(ctx context.Context) error {
-	ips := c.cfg.AllowedIPs
-	if len(ips) == 0 {
-		ips = []string{"*"}
+// doDeviceServer registers the device and returns its ID.
+func (c *Client) doDeviceServer(ctx context.Context) (int, error) {
+	reqBody := deviceServerRequest{
+		Description:  c.cfg.Description,
+		PermittedIPs: c.permittedIPs(),
+		Secret:       c.cfg.APIKey,
 	}
 
-	reqBody := map[string]any{
-		"description":  c.cfg.Description,
-		"secret":       c.cfg.APIKey,
-		"permitted_ips": ips,
+	// device-server uses installation token
+	body, _, err := c.request(ctx, http.MethodPost, "device-server", reqBody, false)
+	if err != nil {
+		return 0, err
 	}
+	return unmarshalID(body)
+}
 
-	// device-server uses installation token
-	_, _, err := c.request(ctx, http.MethodPost, "device-server", reqBody, false)
-	return err
+// permittedIPs returns the IPs the device is registered for.
+func (c *Client) permittedIPs() []string {
+	if len(c.cfg.AllowedIPs) == 0 {
+		return []string{"*"}
+	}
+	return c.cfg.AllowedIPs
 }
 
-func (c *Client) doSessionServer(ctx context.Context) error {
-	reqBody := map[string]string{
-		"secret": c.cfg.APIKey,
+// doDeviceServerReused registers the device on a reused installation. bunq
+// refuses to register the same API key twice on one installation; in that
+// case the registered device is looked up instead.
+func (c *Client) doDeviceServerReused(ctx context.Context) (int, error) {
+	id, err := c.doDeviceServer(ctx)
+	if err == nil || !isDeviceAlreadyRegistered(err) {
+		return id, err
 	}
+	id, ok, lookupErr := c.findDevice(ctx)
+	if lookupErr != nil {
+		return 0, fmt.Errorf("looking up registered device: %w", lookupErr)
+	}
+	if !ok {
+		return 0, err
+	}
+	return id, nil
+}
+
+// isDeviceAlreadyRegistered reports whether err is bunq's refusal to
+// register a device that is registered already.
+func isDeviceAlreadyRegistered(err error) bool {
+	var badReq *BadRequestError
+	if !errors.As(err, &badReq) {
+		return false
+	}
+	return slices.ContainsFunc(badReq.Messages, func(m string) bool {
+		return strings.Contains(strings.ToLower(m), "already registered")
+	})
+}
+
+// findDevice looks for an active device registered with the configured
+// description and one of the permitted IPs, and returns its ID. IPs are
+// compared literally; "*" only matches a device registered for "*".
+func (c *Client) findDevice(ctx context.Context) (int, bool, error) {
+	ips := c.permittedIPs()
+	params := url.Values{"count": {"200"}}
+	for {
+		// device-server uses installation token
+		body, _, err := c.request(ctx, http.MethodGet, "device-server?"+params.Encode(), nil, false)
+		if err != nil {
+			return 0, false, err
+		}
+		list, err := unmarshalList[DeviceServer](body, "DeviceServer")
+		if err != nil {
+			return 0, false, err
+		}
+		for _, d := range list.Items {
+			if d.Status == "ACTIVE" && d.Description == c.cfg.Description && slices.Contains(ips, d.IP) {
+				return d.ID, true, nil
+			}
+		}
+		olderID, ok := list.Pagination.olderID()
+		if !ok || len(list.Items) == 0 {
+			return 0, false, nil
+		}
+		params.Set("older_id", strconv.Itoa(olderID))
+	}
+}
+
+// doSessionServer opens a session and returns its ID and the user type,
+// e.g. "UserPerson".
+func (c *Client) doSessionServer(ctx context.Context) (int, string, error) {
+	reqBody := sessionServerRequest{Secret: c.cfg.APIKey}
 
 	body, _, err := c.request(ctx, http.MethodPost, "session-server", reqBody, false)
 	if err != nil {
-		return err
+		return 0, "", err
 	}
 
 	return c.parseSessionResponse(body)
 }
 
-func (c *Client) parseSessionResponse(body []byte) error {
+func (c *Client) parseSessionResponse(body []byte) (int, string, error) {
 	var envelope struct {
-		Response []json.RawMessage `json:"Response"`
+		Response responseItems `json:"Response"`
 	}
 	if err := json.Unmarshal(body, &envelope); err != nil {
-		return fmt.Errorf("parsing session response: %w", err)
+		return 0, "", fmt.Errorf("parsing session response: %w", err)
 	}
 
 	var sessionTimeout int
+	var sessionID, userID int
+	var userType, sessionToken string
 
 	for _, raw := range envelope.Response {
 		var item map[string]json.RawMessage
@@ -165,14 +328,24 @@ func (c *Client) parseSessionResponse(body []byte) error {
 			continue
 		}
 
+		if idJSON, ok := item["Id"]; ok {
+			var id struct {
+				ID int `json:"id"`
+			}
+			if err := json.Unmarshal(idJSON, &id); err != nil {
+				return 0, "", fmt.Errorf("parsing session ID: %w", err)
+			}
+			sessionID = id.ID
+		}
+
 		if tokenJSON, ok := item["Token"]; ok {
 			var token struct {
 				Token string `json:"token"`
 			}
 			if err := json.Unmarshal(tokenJSON, &token); err != nil {
-				return fmt.Errorf("parsing session token: %w", err)
+				return 0, "", fmt.Errorf("parsing session token: %w", err)
 			}
-			c.sessionToken = token.Token
+			sessionToken = token.Token
 		}
 
 		// User can be UserPerson, UserCompany, UserApiKey, etc.
@@ -185,39 +358,87 @@ func (c *Client) parseSessionResponse(body []byte) error {
 				SessionTimeout int `json:"session_timeout"`
 			}
 			if err := json.Unmarshal(val, &user); err == nil && user.ID > 0 {
-				c.userID = user.ID
+				userID = user.ID
+				userType = key
 				sessionTimeout = user.SessionTimeout
 			}
 		}
 	}
 
-	if c.sessionToken == "" {
-		return fmt.Errorf("no session token in response")
+	// Validate everything before installing the session, so a rejected
+	// response leaves the previous one in place.
+	if sessionToken == "" {
+		return 0, "", fmt.Errorf("no session token in response")
 	}
-	if c.userID == 0 {
-		return fmt.Errorf("no user ID in response")
+	if userID == 0 {
+		return 0, "", fmt.Errorf("no user ID in response")
+	}
+	// userID is read without locking when building paths, so it is only set
+	// while bootstrapping. A refreshed session belongs to the same user.
+	if c.userID != 0 && userID != c.userID {
+		return 0, "", fmt.Errorf("session user ID changed from %d to %d", c.userID, userID)
 	}
 
 	if sessionTimeout == 0 {
 		sessionTimeout = 1800 // default 30 minutes
 	}
-	c.sessionExpiry = time.Now().Add(time.Duration(sessionTimeout) * time.Second)
+	if c.userID == 0 {
+		c.userID = userID
+	}
+	c.sessionToken = sessionToken
+	c.sessionTimeout = time.Duration(sessionTimeout) * time.Second
+	c.sessionExpiry = c.now().Add(c.sessionTimeout)
 
-	return nil
+	return sessionID, userType, nil
 }
 
+// Defaults for Config.PrimaryAccountAttempts and
+// Config.PrimaryAccountPollInterval.
+const (
+	defaultPrimaryAccountAttempts     = 5
+	defaultPrimaryAccountPollInterval = time.Second
+)
+
+// findPrimaryAccount sets the primary monetary account to the first active
+// one, polling until one is active or the attempts are used up.
 func (c *Client) findPrimaryAccount(ctx context.Context) error {
+	attempts := c.cfg.PrimaryAccountAttempts
+	if attempts <= 0 {
+		attempts = defaultPrimaryAccountAttempts
+	}
+	interval := c.cfg.PrimaryAccountPollInterval
+	if interval <= 0 {
+		interval = defaultPrimaryAccountPollInterval
+	}
+
+	for attempt := 1; ; attempt++ {
+		found, err := c.findActiveAccount(ctx)
+		if err != nil || found {
+			return err
+		}
+		if attempt >= attempts {
+			return fmt.Errorf("no active monetary account found after %d attempts", attempts)
+		}
+		if err := c.sleep(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
+
+// findActiveAccount looks up the user's monetary accounts once and reports
+// whether one of them is active.
+func (c *Client) findActiveAccount(ctx context.Context) (bool, error) {
 	path := fmt.Sprintf("user/%d/monetary-account", c.userID)
 	body, _, err := c.get(ctx, path, nil)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	var envelope struct {
-		Response []json.RawMessage `json:"Response"`
+		Response responseItems `json:"Response"`
 	}
 	if err := json.Unmarshal(body, &envelope); err != nil {
-		return fmt.Errorf("parsing monetary accounts: %w", err)
+		return false, fmt.Errorf("parsing monetary accounts: %w", err)
 	}
 
 	for _, raw := range envelope.Response {
@@ -232,23 +453,80 @@ func (c *Client) findPrimaryAccount(ctx context.Context) error {
 			}
 			if err := json.Unmarshal(val, &account); err == nil && account.Status == "ACTIVE" && account.ID > 0 {
 				c.primaryMonetaryAccountID = account.ID
-				return nil
+				return true, nil
 			}
 		}
 	}
 
-	return fmt.Errorf("no active monetary account found")
+	return false, nil
 }
 
 func (c *Client) ensureSessionActive(ctx context.Context) error {
+	attempts := c.cfg.SessionRefreshAttempts
+	if attempts <= 0 {
+		attempts = defaultSessionRefreshAttempts
+	}
+	for attempt := 1; ; attempt++ {
+		err := c.refreshExpiringSession(ctx)
+		if err == nil || attempt >= attempts || !sessionRefreshRetryable(err) {
+			return err
+		}
+		// Wait without the lock, so other requests on a still valid session
+		// are not held up; the next attempt checks the expiry again.
+		if err := c.sleep(ctx, min(time.Second<<(attempt-1), c.retryMaxDelay())); err != nil {
+			return err
+		}
+	}
+}
+
+// refreshExpiringSession opens a new session if the current one is about to
+// expire and no other goroutine has replaced it meanwhile.
+func (c *Client) refreshExpiringSession(ctx context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if time.Until(c.sessionExpiry) > 30*time.Second {
+	if c.sessionExpiry.Sub(c.now()) > c.sessionRefreshThreshold() {
 		return nil
 	}
+	// The session-server POST is not the request an idempotency key on ctx
+	// was meant for.
+	_, _, err := c.doSessionServer(withoutIdempotencyKey(ctx))
+	return err
+}
+
+// defaultSessionRefreshAttempts is used when Config.SessionRefreshAttempts
+// is zero.
+const defaultSessionRefreshAttempts = 3
+
+// sessionRefreshRetryable reports whether a failed session refresh may
+// succeed when tried again: bunq could not be reached or had a server error.
+// Opening a session is safe to repeat, unlike most POSTs. Other API errors,
+// such as a revoked API key, and invalid responses are final.
+func sessionRefreshRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr) || statusCode(err) >= http.StatusInternalServerError
+}
 
-	return c.doSessionServer(ctx)
+// defaultSessionRefreshThreshold is used when Config.SessionRefreshThreshold
+// is zero.
+const defaultSessionRefreshThreshold = 30 * time.Second
+
+// sessionRefreshThreshold returns how long before expiry the session is
+// refreshed. It is at most half the session's lifetime: a larger threshold
+// would open a new session for every request. Callers hold c.mu, or are
+// bootstrapping.
+func (c *Client) sessionRefreshThreshold() time.Duration {
+	threshold := defaultSessionRefreshThreshold
+	if c.cfg.SessionRefreshThreshold > 0 {
+		threshold = c.cfg.SessionRefreshThreshold
+	}
+	if c.sessionTimeout > 0 {
+		threshold = min(threshold, c.sessionTimeout/2)
+	}
+	return threshold
 }
 
 // UserID returns the authenticated user's ID.
@@ -256,6 +534,14 @@ func (c *Client) UserID() int {
 	return c.userID
 }
 
+// ServerPublicKey returns bunq's public key received during installation. Use
+// it to verify the X-Bunq-Server-Signature header of callbacks.
+func (c *Client) ServerPublicKey() *rsa.PublicKey {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.serverPublicKey
+}
+
 // PrimaryMonetaryAccountID returns the primary monetary account ID.
 func (c *Client) PrimaryMonetaryAccountID() int {
 	return c.primaryMonetaryAccountID
@@ -0,0 +1,70 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// clockSkew remembers the difference between bunq's clock, from the Date
+// header of the last response, and the client's.
+type clockSkew struct {
+	mu    sync.Mutex
+	skew  time.Duration
+	known bool
+}
+
+// record notes the skew shown by the Date header of a response received at
+// local time received. Responses without a valid Date header are ignored.
+func (s *clockSkew) record(header http.Header, received time.Time) (time.Duration, bool) {
+	date, err := http.ParseTime(header.Get("Date"))
+	if err != nil {
+		return 0, false
+	}
+	skew := date.Sub(received)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.skew, s.known = skew, true
+	return skew, true
+}
+
+func (s *clockSkew) last() (time.Duration, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.skew, s.known
+}
+
+// ServerTimeSkew asks bunq for its time and returns how far it is ahead of
+// the local clock; negative means behind. The Date header it is read from
+// has a resolution of one second.
+//
+// bunq rejects signed requests from clients whose clock is too far off, so
+// a large skew explains signature and session errors. To work when those
+// occur, the request is neither authenticated nor signed, and any response
+// will do.
+func (c *Client) ServerTimeSkew(ctx context.Context) (time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/", nil)
+	if err != nil {
+		return 0, fmt.Errorf("creating request: %w", err)
+	}
+	setDefaultHeaders(req)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("executing request: %w", err)
+	}
+	resp.Body.Close()
+	skew, ok := c.skew.record(resp.Header, c.now())
+	if !ok {
+		return 0, fmt.Errorf("no valid Date header in response")
+	}
+	return skew, nil
+}
+
+// LastServerTimeSkew returns the clock skew shown by the last response from
+// bunq, as ServerTimeSkew does, without making a request. ok is false
+// before any response carried a Date header.
+func (c *Client) LastServerTimeSkew() (skew time.Duration, ok bool) {
+	return c.skew.last()
+}
@@ -0,0 +1,111 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// The tab result endpoints are not part of the Python SDK, so they are
+// maintained by hand here. Once a customer pays a Tab, bunq records the
+// payment as a tab result: merchants list TabResultInquiry per Tab, or
+// TabResultResponse per account, to match incoming payments to their Tabs.
+
+// TabResultInquiry links a Tab to a payment made for it.
+type TabResultInquiry struct {
+	ID      int           `json:"id,omitempty"`
+	Created string        `json:"created,omitempty"`
+	Updated string        `json:"updated,omitempty"`
+	Tab     *TabReference `json:"tab,omitempty"`
+	Payment *Payment      `json:"payment,omitempty"`
+}
+
+// TabResultResponse is the customer's side of a paid Tab, as listed on the
+// paying monetary account.
+type TabResultResponse struct {
+	ID                           int                        `json:"id,omitempty"`
+	Created                      string                     `json:"created,omitempty"`
+	Updated                      string                     `json:"updated,omitempty"`
+	Tab                          *TabReference              `json:"tab,omitempty"`
+	Payment                      *Payment                   `json:"payment,omitempty"`
+	RequestReferenceSplitTheBill []*RequestInquiryReference `json:"request_reference_split_the_bill,omitempty"`
+}
+
+// TabReference is the Tab a result belongs to. Exactly one field is set,
+// depending on whether the Tab can be paid once or many times.
+type TabReference struct {
+	TabUsageSingle   *Tab `json:"TabUsageSingle,omitempty"`
+	TabUsageMultiple *Tab `json:"TabUsageMultiple,omitempty"`
+}
+
+// Object returns the Tab, whichever kind it is, or nil if none is set.
+func (r *TabReference) Object() any {
+	if t := r.Tab(); t != nil {
+		return t
+	}
+	return nil
+}
+
+// Tab returns the Tab, whichever kind it is, or nil if none is set.
+func (r *TabReference) Tab() *Tab {
+	if r.TabUsageSingle != nil {
+		return r.TabUsageSingle
+	}
+	return r.TabUsageMultiple
+}
+
+// Tab holds the fields common to single- and multiple-use Tabs.
+type Tab struct {
+	UUID              string  `json:"uuid,omitempty"`
+	Created           string  `json:"created,omitempty"`
+	Updated           string  `json:"updated,omitempty"`
+	MerchantReference string  `json:"merchant_reference,omitempty"`
+	Description       string  `json:"description,omitempty"`
+	Status            string  `json:"status,omitempty"`
+	AmountTotal       *Amount `json:"amount_total,omitempty"`
+	AmountPaid        *Amount `json:"amount_paid,omitempty"`
+	QrCodeToken       string  `json:"qr_code_token,omitempty"`
+	TabURL            string  `json:"tab_url,omitempty"`
+}
+
+type TabResultInquiryService struct{ *service }
+
+// Get returns a single result of a Tab.
+func (s *TabResultInquiryService) Get(ctx context.Context, monetaryAccountID int, cashRegisterID int, tabUUID string, tabResultInquiryID int) (*TabResultInquiry, error) {
+	if err := validateUUID("tabUUID", tabUUID); err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("user/%d/monetary-account/%d/cash-register/%d/tab/%s/tab-result-inquiry/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), cashRegisterID, tabUUID, tabResultInquiryID)
+	body, _, err := s.client.get(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalObject[TabResultInquiry](body, "TabResultInquiry")
+}
+
+// List iterates over the payments made for a Tab.
+func (s *TabResultInquiryService) List(ctx context.Context, monetaryAccountID int, cashRegisterID int, tabUUID string, opts *ListOptions) iter.Seq2[TabResultInquiry, error] {
+	if err := validateUUID("tabUUID", tabUUID); err != nil {
+		return errIter[TabResultInquiry](err)
+	}
+	path := fmt.Sprintf("user/%d/monetary-account/%d/cash-register/%d/tab/%s/tab-result-inquiry", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), cashRegisterID, tabUUID)
+	return listIter[TabResultInquiry](s.client, ctx, path, "TabResultInquiry", opts)
+}
+
+type TabResultResponseService struct{ *service }
+
+// Get returns a single Tab payment made from a monetary account.
+func (s *TabResultResponseService) Get(ctx context.Context, monetaryAccountID int, tabResultResponseID int) (*TabResultResponse, error) {
+	path := fmt.Sprintf("user/%d/monetary-account/%d/tab-result-response/%d", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID), tabResultResponseID)
+	body, _, err := s.client.get(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalObject[TabResultResponse](body, "TabResultResponse")
+}
+
+// List iterates over the Tab payments made from a monetary account.
+func (s *TabResultResponseService) List(ctx context.Context, monetaryAccountID int, opts *ListOptions) iter.Seq2[TabResultResponse, error] {
+	path := fmt.Sprintf("user/%d/monetary-account/%d/tab-result-response", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
+	return listIter[TabResultResponse](s.client, ctx, path, "TabResultResponse", opts)
+}
@@ -0,0 +1,54 @@
+package bunq
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// testKey is shared by all test clients, as generating a key is slow.
+var testKey = sync.OnceValues(generateRSAKeyPair)
+
+// TestClientOption configures a client created by NewTestClient.
+type TestClientOption func(*Client)
+
+// WithTestUserID sets the user ID of a test client. The default is 1.
+func WithTestUserID(id int) TestClientOption {
+	return func(c *Client) { c.userID = id }
+}
+
+// WithTestPrimaryMonetaryAccountID sets the primary monetary account ID of a
+// test client. The default is 2.
+func WithTestPrimaryMonetaryAccountID(id int) TestClientOption {
+	return func(c *Client) { c.primaryMonetaryAccountID = id }
+}
+
+// NewTestClient returns a client that skips bootstrap and sends all requests
+// to baseURL, typically an httptest server, so code using bunq-go can be
+// unit-tested against a mock. The client has a session that never expires and
+// signs requests, but does not verify response signatures.
+func NewTestClient(httpClient *http.Client, baseURL string, opts ...TestClientOption) *Client {
+	key, err := testKey()
+	if err != nil {
+		panic("bunq: generating test key: " + err.Error())
+	}
+	c := newClient(Config{
+		Environment: Environment{BaseURL: baseURL},
+		HTTPClient:  httpClient,
+	})
+	c.privateKey = key
+	c.installationToken = "test-installation"
+	c.sessionToken = "test-session"
+	c.sessionExpiry = time.Now().AddDate(100, 0, 0)
+	c.userID = 1
+	c.primaryMonetaryAccountID = 2
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.bootstrap.UserID = c.userID
+	c.bootstrap.PrimaryMonetaryAccountID = c.primaryMonetaryAccountID
+
+	c.initServices()
+	c.initCustomServices()
+	return c
+}
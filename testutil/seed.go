@@ -0,0 +1,147 @@
+// Package testutil provisions test data in the bunq sandbox, for integration
+// tests of code built on bunq-go.
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	bunq "github.com/gwillem/bunq-go"
+)
+
+// SugarDaddy is the sandbox user that accepts money requests of up to
+// EUR 500 and receives test payments.
+const SugarDaddy = "sugardaddy@bunq.com"
+
+// pollInterval is the delay between checks for settled requests. It keeps
+// polling within bunq's rate limit of 3 GET calls per 3 seconds.
+const pollInterval = time.Second
+
+// SeedSpec describes the test data SeedSandbox provisions.
+type SeedSpec struct {
+	MonetaryAccountID int // 0 for the primary account
+
+	// Requests is the number of money requests to SugarDaddy, which funds
+	// the account, each of RequestAmount (default EUR 100).
+	Requests      int
+	RequestAmount *bunq.Amount
+
+	// Payments is the number of payments to SugarDaddy, each of
+	// PaymentAmount (default EUR 0.01). The account must hold enough money
+	// for them, e.g. from Requests.
+	Payments      int
+	PaymentAmount *bunq.Amount
+
+	// Tag starts the description of each seeded item, by which SeedSandbox
+	// recognizes the ones it made before. Default "bunq-go seed".
+	Tag string
+}
+
+// SeedResult holds the IDs of the seeded items, including those seeded by
+// earlier calls.
+type SeedResult struct {
+	RequestIDs []int
+	PaymentIDs []int
+}
+
+// SeedSandbox makes sure the account holds the test data in spec, creating
+// only what earlier calls with the same Tag did not. It returns once the
+// requests have been accepted and the payments have settled, or when ctx is
+// done; bound it with a deadline.
+func SeedSandbox(ctx context.Context, client *bunq.Client, spec SeedSpec) (*SeedResult, error) {
+	if spec.Tag == "" {
+		spec.Tag = "bunq-go seed"
+	}
+	if spec.RequestAmount == nil {
+		spec.RequestAmount = bunq.NewAmount(100, "EUR")
+	}
+	if spec.PaymentAmount == nil {
+		spec.PaymentAmount = bunq.NewAmount(0.01, "EUR")
+	}
+	acct := spec.MonetaryAccountID
+	res := &SeedResult{}
+
+	// Requests first, so the payments can be paid from them.
+	noBunqme := false
+	existing := map[string]int{}
+	for r, err := range client.RequestInquiry.List(ctx, acct, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("listing requests: %w", err)
+		}
+		if strings.HasPrefix(r.Description, spec.Tag) {
+			existing[r.Description] = r.ID
+		}
+	}
+	for i := range spec.Requests {
+		desc := fmt.Sprintf("%s request %d", spec.Tag, i+1)
+		id, ok := existing[desc]
+		if !ok {
+			var err error
+			id, err = client.RequestInquiry.Create(ctx, acct, bunq.RequestInquiryCreateParams{
+				AmountInquired:    spec.RequestAmount,
+				CounterpartyAlias: &bunq.Pointer{Type: "EMAIL", Value: SugarDaddy},
+				Description:       desc,
+				AllowBunqme:       &noBunqme,
+				RequireAddress:    "NONE",
+			})
+			if err != nil {
+				return nil, fmt.Errorf("creating %q: %w", desc, err)
+			}
+		}
+		if err := waitAccepted(ctx, client, acct, id); err != nil {
+			return nil, fmt.Errorf("waiting for %q: %w", desc, err)
+		}
+		res.RequestIDs = append(res.RequestIDs, id)
+	}
+
+	existing = map[string]int{}
+	for p, err := range client.Payment.List(ctx, acct, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("listing payments: %w", err)
+		}
+		if strings.HasPrefix(p.Description, spec.Tag) {
+			existing[p.Description] = p.ID
+		}
+	}
+	for i := range spec.Payments {
+		desc := fmt.Sprintf("%s payment %d", spec.Tag, i+1)
+		if id, ok := existing[desc]; ok {
+			res.PaymentIDs = append(res.PaymentIDs, id)
+			continue
+		}
+		p, err := client.SendPayment(ctx, acct, bunq.PaymentCreateParams{
+			Amount:            spec.PaymentAmount,
+			CounterpartyAlias: &bunq.Pointer{Type: "EMAIL", Value: SugarDaddy},
+			Description:       desc,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("sending %q: %w", desc, err)
+		}
+		res.PaymentIDs = append(res.PaymentIDs, p.ID)
+	}
+
+	return res, nil
+}
+
+// waitAccepted polls a request until SugarDaddy has accepted it.
+func waitAccepted(ctx context.Context, client *bunq.Client, acct, id int) error {
+	for {
+		r, err := client.RequestInquiry.Get(ctx, acct, id)
+		if err != nil {
+			return err
+		}
+		switch r.Status {
+		case "ACCEPTED":
+			return nil
+		case "REJECTED", "REVOKED", "EXPIRED":
+			return fmt.Errorf("request %d was %s", id, strings.ToLower(r.Status))
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
@@ -0,0 +1,48 @@
+//go:build integration
+
+package testutil
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	bunq "github.com/gwillem/bunq-go"
+)
+
+func TestSeedSandbox(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	apiKey, err := bunq.CreateSandboxAPIKey()
+	if err != nil {
+		t.Fatalf("creating sandbox API key: %v", err)
+	}
+	client, err := bunq.NewClient(ctx, bunq.Config{
+		APIKey:      apiKey,
+		Environment: bunq.Sandbox,
+		Description: "bunq-go-seed-test",
+	})
+	if err != nil {
+		t.Fatalf("creating client: %v", err)
+	}
+
+	spec := SeedSpec{Requests: 1, Payments: 2}
+	first, err := SeedSandbox(ctx, client, spec)
+	if err != nil {
+		t.Fatalf("seeding: %v", err)
+	}
+	if len(first.RequestIDs) != 1 || len(first.PaymentIDs) != 2 {
+		t.Fatalf("unexpected result %+v", first)
+	}
+
+	// Seeding again finds the same items instead of creating new ones.
+	second, err := SeedSandbox(ctx, client, spec)
+	if err != nil {
+		t.Fatalf("seeding again: %v", err)
+	}
+	if !slices.Equal(first.RequestIDs, second.RequestIDs) || !slices.Equal(first.PaymentIDs, second.PaymentIDs) {
+		t.Errorf("expected the same items, got %+v and %+v", first, second)
+	}
+}
@@ -0,0 +1,60 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+)
+
+// SofortMerchantTransactionCreateParams holds the fields for starting a
+// SOFORT top-up. The Python SDK only reads SOFORT transactions, so this and
+// SofortMerchantTransactionService.Create are maintained by hand.
+type SofortMerchantTransactionCreateParams struct {
+	AmountRequested *Amount `json:"amount_requested,omitempty"`
+	Issuer          string  `json:"issuer,omitempty"` // optional BIC of the user's bank
+}
+
+// Create starts a SOFORT top-up of a monetary account and returns its ID.
+func (s *SofortMerchantTransactionService) Create(ctx context.Context, monetaryAccountID int, params SofortMerchantTransactionCreateParams) (int, error) {
+	path := fmt.Sprintf("user/%d/monetary-account/%d/sofort-merchant-transaction", s.client.userID, s.client.resolveMonetaryAccountID(monetaryAccountID))
+	body, _, err := s.client.post(ctx, path, params)
+	if err != nil {
+		return 0, err
+	}
+	return unmarshalID(body)
+}
+
+// TopUp starts an iDEAL top-up of amount into a monetary account and returns
+// the URL where the user authorizes it at their bank, in the browser. issuer
+// is the BIC of that bank, e.g. "INGBNL2A". Follow the top-up with Get.
+func (s *IdealMerchantTransactionService) TopUp(ctx context.Context, monetaryAccountID int, amount *Amount, issuer string) (string, error) {
+	t, err := s.CreateAndFetch(ctx, monetaryAccountID, IdealMerchantTransactionCreateParams{
+		AmountRequested: amount,
+		Issuer:          issuer,
+	})
+	if err != nil {
+		return "", err
+	}
+	return issuerAuthenticationURL("iDEAL", t.IssuerAuthenticationURL)
+}
+
+// TopUp starts a SOFORT top-up of amount into a monetary account and returns
+// the URL where the user authorizes it, in the browser. Follow the top-up
+// with Get.
+func (s *SofortMerchantTransactionService) TopUp(ctx context.Context, monetaryAccountID int, amount *Amount) (string, error) {
+	id, err := s.Create(ctx, monetaryAccountID, SofortMerchantTransactionCreateParams{AmountRequested: amount})
+	if err != nil {
+		return "", err
+	}
+	t, err := s.Get(ctx, monetaryAccountID, id)
+	if err != nil {
+		return "", err
+	}
+	return issuerAuthenticationURL("SOFORT", t.IssuerAuthenticationURL)
+}
+
+func issuerAuthenticationURL(method, url string) (string, error) {
+	if url == "" {
+		return "", fmt.Errorf("%s top-up has no issuer authentication URL", method)
+	}
+	return url, nil
+}
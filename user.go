@@ -0,0 +1,60 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+)
+
+// UserProfile is a normalized view of the authenticated user, independent of
+// which concrete user type bunq returns.
+type UserProfile struct {
+	ID             int
+	Type           string // UserPerson, UserCompany, UserApiKey or UserPaymentServiceProvider
+	DisplayName    string
+	PublicNickName string
+	Status         string
+	Address        *Address
+	Avatar         *Avatar
+
+	// User holds the full polymorphic object; exactly one of its fields is set.
+	User *User
+}
+
+// CurrentUser fetches the authenticated user. It is not named User so that it
+// does not shadow the generated client.User service.
+func (c *Client) CurrentUser(ctx context.Context) (*UserProfile, error) {
+	path := fmt.Sprintf("user/%d", c.userID)
+	body, _, err := c.get(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := unmarshalObject[User](body, "User")
+	if err != nil {
+		return nil, err
+	}
+	return newUserProfile(user)
+}
+
+func newUserProfile(u *User) (*UserProfile, error) {
+	p := &UserProfile{User: u}
+	switch {
+	case u.UserPerson != nil:
+		up := u.UserPerson
+		p.ID, p.Type, p.DisplayName, p.PublicNickName = up.ID, "UserPerson", up.DisplayName, up.PublicNickName
+		p.Status, p.Address, p.Avatar = up.Status, up.AddressMain, up.Avatar
+	case u.UserCompany != nil:
+		uc := u.UserCompany
+		p.ID, p.Type, p.DisplayName, p.PublicNickName = uc.ID, "UserCompany", uc.DisplayName, uc.PublicNickName
+		p.Status, p.Address, p.Avatar = uc.Status, uc.AddressMain, uc.Avatar
+	case u.UserPaymentServiceProvider != nil:
+		us := u.UserPaymentServiceProvider
+		p.ID, p.Type, p.DisplayName, p.PublicNickName = us.ID, "UserPaymentServiceProvider", us.DisplayName, us.PublicNickName
+		p.Status, p.Avatar = us.Status, us.Avatar
+	case u.UserApiKey != nil:
+		p.ID, p.Type = u.UserApiKey.ID, "UserApiKey"
+	default:
+		return nil, fmt.Errorf("no known user type in response")
+	}
+	return p, nil
+}